@@ -0,0 +1,127 @@
+// Command chunkgen validates the chunk template files in chunks/ and
+// generates the Go source the game is built with.
+//
+// Every file is parsed (see internal/chunkfile for the format), template
+// names must be unique, and each template must be solvable at every level it
+// appears at. The templates are then written to
+// internal/game/generators/templates_gen.go. With -check nothing is written;
+// it exits with status 1 when a file is invalid or the generated source is
+// out of date.
+//
+// Usage:
+//
+//	go run ./cmd/chunkgen [-check] [-dir chunks] [-out internal/game/generators/templates_gen.go]
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"GolangGame251130/internal/chunkfile"
+	"GolangGame251130/internal/game"
+	"GolangGame251130/internal/game/generators"
+)
+
+// checkLevels is the highest level templates without max-level are checked at.
+// Speed keeps growing after it, but the generator's difficulty stops scaling there.
+const checkLevels = 10
+
+func main() {
+	check := flag.Bool("check", false, "only validate; fail if the generated source is out of date")
+	dir := flag.String("dir", "chunks", "directory of the .chunk files")
+	out := flag.String("out", filepath.Join("internal", "game", "generators", "templates_gen.go"), "Go file to generate")
+	flag.Parse()
+
+	chunks, ok := load(*dir)
+	if !ok {
+		os.Exit(1)
+	}
+
+	src, err := chunkfile.Generate(chunks)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "chunkgen: %v\n", err)
+		os.Exit(1)
+	}
+
+	if *check {
+		current, err := os.ReadFile(*out)
+		if err != nil || !bytes.Equal(current, src) {
+			fmt.Fprintf(os.Stderr, "chunkgen: %s is out of date; run go run ./cmd/chunkgen\n", *out)
+			os.Exit(1)
+		}
+		fmt.Printf("%d chunks ok\n", len(chunks))
+		return
+	}
+
+	if err := os.WriteFile(*out, src, 0o644); err != nil {
+		fmt.Fprintf(os.Stderr, "chunkgen: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("wrote %d chunks to %s\n", len(chunks), *out)
+}
+
+// load parses and validates every chunk file, reporting all problems.
+func load(dir string) ([]*chunkfile.Chunk, bool) {
+	paths, err := filepath.Glob(filepath.Join(dir, "*.chunk"))
+	if err != nil || len(paths) == 0 {
+		fmt.Fprintf(os.Stderr, "chunkgen: no .chunk files in %s\n", dir)
+		return nil, false
+	}
+
+	ok := true
+	chunks := []*chunkfile.Chunk{}
+	names := map[string]string{}
+	for _, path := range paths {
+		c, err := parseFile(path)
+		if err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			ok = false
+			continue
+		}
+		if other, dup := names[c.Template.Name]; dup {
+			fmt.Fprintf(os.Stderr, "%s: name %q is already used by %s\n", path, c.Template.Name, other)
+			ok = false
+			continue
+		}
+		names[c.Template.Name] = path
+		if !checkSolvable(c) {
+			ok = false
+			continue
+		}
+		chunks = append(chunks, c)
+	}
+	return chunks, ok
+}
+
+func parseFile(path string) (*chunkfile.Chunk, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return chunkfile.Parse(filepath.ToSlash(path), f)
+}
+
+// checkSolvable runs the solvability checker at every level the template appears at.
+func checkSolvable(c *chunkfile.Chunk) bool {
+	t := &c.Template
+	last := t.MaxLevel
+	if last == 0 {
+		last = checkLevels
+	}
+	for level := t.MinLevel; level <= last; level++ {
+		res := generators.CheckTemplate(t, level)
+		if res.Solvable {
+			continue
+		}
+		fmt.Fprintf(os.Stderr, "%s: unsolvable at level %d, blocked by:\n", c.File, level)
+		for _, h := range res.Blocking {
+			fmt.Fprintf(os.Stderr, "    line %d lane %d column %d %s\n", h.Line, h.Lane, generators.TemplateColumn(h.X)+1, game.ItemDefs[h.Kind].Name)
+		}
+		return false
+	}
+	return true
+}
@@ -0,0 +1,164 @@
+package main
+
+import (
+	"GolangGame251130/internal/game"
+	"GolangGame251130/internal/headless"
+)
+
+// Bot decides which buttons to press for the current frame.
+type Bot interface {
+	Act(g *game.Game, input *headless.Input)
+}
+
+func newBot(name string, seed uint32) (Bot, bool) {
+	switch name {
+	case "heuristic":
+		return &heuristicBot{}, true
+	case "idle":
+		return idleBot{}, true
+	case "random":
+		return &randomBot{rng: game.NewRNG(seed)}, true
+	case "novice":
+		return &noviceBot{rng: game.NewRNG(seed)}, true
+	}
+	return nil, false
+}
+
+// idleBot never presses anything. Useful as a baseline.
+type idleBot struct{}
+
+func (idleBot) Act(g *game.Game, input *headless.Input) {}
+
+// randomBot mashes buttons at random.
+type randomBot struct {
+	rng *game.RNG
+}
+
+func (b *randomBot) Act(g *game.Game, input *headless.Input) {
+	for _, button := range []game.Button{game.ButtonA, game.ButtonB, game.ButtonX} {
+		if b.rng.Intn(60) == 0 {
+			input.Press(button)
+		}
+	}
+}
+
+// noviceBot plays like heuristicBot but now and then looks away for a moment,
+// like a player still learning the game.
+type noviceBot struct {
+	heuristicBot
+	rng        *game.RNG
+	distracted int // Frames left without reacting
+}
+
+func (b *noviceBot) Act(g *game.Game, input *headless.Input) {
+	if b.distracted > 0 {
+		b.distracted--
+		return
+	}
+	if b.rng.Intn(60) == 0 {
+		b.distracted = 20 + b.rng.Intn(40)
+		return
+	}
+	b.heuristicBot.Act(g, input)
+}
+
+// heuristicBot looks a few grids ahead in every lane and dodges threats,
+// picks up food and hands the pickaxe to the line that needs it.
+type heuristicBot struct {
+	swapCooldown int
+}
+
+const (
+	lookahead = 96.0 // px ahead of the player to consider
+	noThreat  = 1e9
+)
+
+type laneView struct {
+	threat    float32 // distance to the nearest item that hurts
+	breakable float32 // distance to the nearest rock the pickaxe can break
+	food      float32 // distance to the nearest food
+}
+
+func scanLane(line *game.Line, lane int, hasPickaxe bool) laneView {
+	v := laneView{threat: noThreat, breakable: noThreat, food: noThreat}
+	playerPos, playerWidth, _ := line.GetPlayer().GetBounds()
+	laneY := line.GetLaneY(lane)
+
+	for _, item := range line.GetItems() {
+		pos := item.GetPosition()
+		if pos.Y != laneY {
+			continue
+		}
+		dist := pos.X - (playerPos.X + float32(playerWidth))
+		if pos.X+float32(item.Width()) < playerPos.X || dist > lookahead {
+			continue
+		}
+		if dist < 0 {
+			dist = 0
+		}
+
+		if !item.IsObstacle() {
+			v.food = min(v.food, dist)
+			continue
+		}
+		if !item.Def().Breakable {
+			v.threat = min(v.threat, dist)
+			continue
+		}
+		v.breakable = min(v.breakable, dist)
+		if !hasPickaxe {
+			v.threat = min(v.threat, dist)
+		}
+	}
+	return v
+}
+
+func (b *heuristicBot) Act(g *game.Game, input *headless.Input) {
+	lines := g.GetLines()
+	buttons := []game.Button{game.ButtonA, game.ButtonB}
+	owner := g.GetPickaxeOwner()
+
+	// Lane the line will end up in after this frame's decision
+	targetLanes := make([]int, len(lines))
+
+	for i, line := range lines {
+		hasPickaxe := owner == i
+		cur := line.GetCurrentLane()
+		here := scanLane(line, cur, hasPickaxe)
+		there := scanLane(line, 1-cur, hasPickaxe)
+
+		toggle := false
+		switch {
+		case here.threat < noThreat && there.threat > here.threat:
+			toggle = true
+		case here.threat == noThreat && there.food < there.threat && there.food < here.food:
+			toggle = true
+		}
+
+		targetLanes[i] = cur
+		if toggle && i < len(buttons) {
+			input.Press(buttons[i])
+			targetLanes[i] = 1 - cur
+		}
+	}
+
+	// Pickaxe hand-over
+	if b.swapCooldown > 0 {
+		b.swapCooldown--
+		return
+	}
+	if len(lines) < 2 {
+		return
+	}
+	other := 1 - owner
+	ownerNeeds := scanLane(lines[owner], targetLanes[owner], true).breakable
+	otherNeeds := scanLane(lines[other], targetLanes[other], true).breakable
+	// A line stuck between a hard rock and a breakable rock needs the pickaxe to switch lanes
+	if stuck := scanLane(lines[other], targetLanes[other], false); stuck.threat < stuck.breakable {
+		otherNeeds = min(otherNeeds, scanLane(lines[other], 1-targetLanes[other], true).breakable)
+	}
+	if otherNeeds < ownerNeeds {
+		input.Press(game.ButtonX)
+		b.swapCooldown = 6
+	}
+}
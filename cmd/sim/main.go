@@ -0,0 +1,85 @@
+// Command sim plays the game headlessly with a bot and reports run statistics.
+//
+// Runs use the same fixed step as SceneManager.Tick unless -dt is given.
+//
+// Usage:
+//
+//	go run ./cmd/sim -runs 1000 -bot novice
+package main
+
+import (
+	"flag"
+	"fmt"
+	"os"
+
+	"GolangGame251130/internal/game"
+	"GolangGame251130/internal/game/generators"
+	"GolangGame251130/internal/headless"
+)
+
+func main() {
+	runs := flag.Int("runs", 1000, "number of runs to simulate")
+	seed := flag.Uint("seed", 1, "seed of the first run (run i uses seed+i)")
+	botName := flag.String("bot", "novice", "player bot: novice, heuristic, idle or random")
+	maxTime := flag.Float64("maxtime", 600, "stop a run after this many seconds")
+	step := flag.Float64("dt", game.FixedDelta, "simulation step in seconds")
+	genName := flag.String("gen", generators.GeneratorPath, "level generator registered in generators.DefaultRegistry")
+	adaptive := flag.Bool("adaptive", true, "keep the generator's adaptive difficulty (false to compare without it)")
+	flag.Parse()
+
+	factory, ok := generators.DefaultRegistry.Lookup(*genName)
+	if !ok {
+		fmt.Fprintf(os.Stderr, "sim: unknown generator %q\n", *genName)
+		os.Exit(2)
+	}
+	if !*adaptive {
+		factory = withoutAdaptive(factory)
+	}
+
+	if _, ok := newBot(*botName, 0); !ok {
+		fmt.Fprintf(os.Stderr, "sim: unknown bot %q\n", *botName)
+		os.Exit(2)
+	}
+
+	results := make([]runResult, 0, *runs)
+	for i := 0; i < *runs; i++ {
+		runSeed := uint32(*seed) + uint32(i)
+		bot, _ := newBot(*botName, runSeed)
+		results = append(results, simulate(runSeed, factory, bot, float32(*step), float32(*maxTime)))
+	}
+
+	writeReport(os.Stdout, results)
+}
+
+// withoutAdaptive turns off the adaptive difficulty of the path generators.
+func withoutAdaptive(factory game.GeneratorFactory) game.GeneratorFactory {
+	return func(rng *game.RNG) game.LevelGenerator {
+		gen := factory(rng)
+		if pg, ok := gen.(*generators.PathGenerator); ok {
+			pg.SetAdaptive(false)
+		}
+		return gen
+	}
+}
+
+// simulate plays a single run until game over or maxTime seconds.
+func simulate(seed uint32, factory game.GeneratorFactory, bot Bot, dt, maxTime float32) runResult {
+	platform, input, _ := headless.New()
+	g := game.NewGameWithSeed(platform, factory, seed)
+
+	maxFrames := int(maxTime / dt)
+	frames := 0
+	for ; frames < maxFrames && !g.IsGameOver(); frames++ {
+		bot.Act(g, input)
+		g.Update(dt)
+		input.Clear()
+	}
+
+	return runResult{
+		score:    g.GetScore(),
+		level:    g.GetLevel(),
+		cause:    g.GetGameOverCause(),
+		duration: float32(frames) * dt,
+		stats:    g.GetStats(),
+	}
+}
@@ -0,0 +1,122 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"sort"
+
+	"GolangGame251130/internal/game"
+)
+
+// runResult is the outcome of a single simulated run.
+type runResult struct {
+	score    float32
+	level    int
+	cause    game.GameOverCause
+	duration float32 // seconds
+	stats    game.GameStats
+}
+
+// distribution summarizes a set of samples.
+type distribution struct {
+	values []float64
+}
+
+func (d *distribution) add(v float64) {
+	d.values = append(d.values, v)
+}
+
+func (d *distribution) percentile(p float64) float64 {
+	if len(d.values) == 0 {
+		return 0
+	}
+	idx := int(p * float64(len(d.values)-1))
+	return d.values[idx]
+}
+
+func (d *distribution) mean() float64 {
+	if len(d.values) == 0 {
+		return 0
+	}
+	sum := 0.0
+	for _, v := range d.values {
+		sum += v
+	}
+	return sum / float64(len(d.values))
+}
+
+func (d *distribution) write(w io.Writer, name string) {
+	sort.Float64s(d.values)
+	fmt.Fprintf(w, "%-10s mean=%9.1f min=%9.1f p10=%9.1f p50=%9.1f p90=%9.1f max=%9.1f\n",
+		name, d.mean(), d.percentile(0), d.percentile(0.1), d.percentile(0.5), d.percentile(0.9), d.percentile(1))
+}
+
+// deathCauses are the game over causes, in report order. A run that reached
+// -maxtime has no cause and is not a death.
+var deathCauses = []game.GameOverCause{game.GameOverEnergyDrain, game.GameOverDamage}
+
+func causeName(cause game.GameOverCause) string {
+	switch cause {
+	case game.GameOverEnergyDrain:
+		return "energy drain"
+	case game.GameOverDamage:
+		return "rock hits"
+	}
+	return "none"
+}
+
+func percent(n, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(n) / float64(total)
+}
+
+func writeReport(w io.Writer, results []runResult) {
+	var score, level, duration distribution
+	var rocks, food, hits distribution
+	causes := map[game.GameOverCause]int{}
+	levels := map[int]int{}
+	maxLevel := 0
+
+	for _, r := range results {
+		score.add(float64(r.score))
+		level.add(float64(r.level))
+		duration.add(float64(r.duration))
+		rocks.add(float64(r.stats.RocksBroken + r.stats.GoldRocksBroken))
+		food.add(float64(r.stats.FoodEaten))
+		hits.add(float64(r.stats.HardRockHits))
+		causes[r.cause]++
+		levels[r.level]++
+		maxLevel = max(maxLevel, r.level)
+	}
+
+	fmt.Fprintf(w, "runs: %d\n\n", len(results))
+	score.write(w, "score")
+	level.write(w, "level")
+	duration.write(w, "length(s)")
+	rocks.write(w, "rocks")
+	food.write(w, "food")
+	hits.write(w, "hits")
+
+	timeLimit := causes[game.GameOverNone]
+	deaths := len(results) - timeLimit
+	fmt.Fprintln(w, "\nending:")
+	fmt.Fprintf(w, "  %-12s %6d (%5.1f%%)\n", "death", deaths, percent(deaths, len(results)))
+	fmt.Fprintf(w, "  %-12s %6d (%5.1f%%)\n", "time limit", timeLimit, percent(timeLimit, len(results)))
+
+	fmt.Fprintln(w, "\ndeath cause (of deaths):")
+	for _, cause := range deathCauses {
+		n := causes[cause]
+		fmt.Fprintf(w, "  %-12s %6d (%5.1f%%)\n", causeName(cause), n, percent(n, deaths))
+	}
+
+	fmt.Fprintln(w, "\nlevel reached:")
+	for lv := 1; lv <= maxLevel; lv++ {
+		n := levels[lv]
+		if n == 0 {
+			continue
+		}
+		fmt.Fprintf(w, "  %3d %6d (%5.1f%%)\n", lv, n, percent(n, len(results)))
+	}
+}
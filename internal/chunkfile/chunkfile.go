@@ -0,0 +1,206 @@
+// Package chunkfile reads the plain-text chunk template format used by level
+// designers and converts it to generators.ChunkTemplate values and Go source.
+//
+// A chunk file holds one template:
+//
+//	# Line 0 breaks a row of gold rocks while line 1 weaves between hard rocks
+//	name: gold rock gauntlet
+//	min-level: 2
+//	weight: 3
+//
+//	G.G.G.G.
+//	HHHHHHHH
+//
+//	.H...H..
+//	...H...H
+//
+// Lines starting with # are comments; the ones before the first header become
+// the template's description. Headers are "key: value" lines:
+//
+//	name       Template name (required, unique)
+//	min-level  Lowest level the template appears at (default 1)
+//	max-level  Highest level the template appears at (default 0 = no limit)
+//	weight     Relative chance among the templates allowed at a level (default 1)
+//
+// The grid follows the headers: four rows for line 0 lane 0, line 0 lane 1,
+// line 1 lane 0 and line 1 lane 1, one character per 24px grid column. Blank
+// lines between rows are ignored. Rows must have the same length.
+package chunkfile
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"GolangGame251130/internal/game"
+	"GolangGame251130/internal/game/generators"
+)
+
+// Cell is one character of the grid.
+type Cell struct {
+	Char  byte
+	Kind  game.ItemKind
+	Ident string // Go expression in the generators package
+}
+
+// Cells lists the characters a grid may use.
+var Cells = []Cell{
+	{'.', generators.Empty, "Empty"},
+	{'R', game.ItemRock, "game.ItemRock"},
+	{'H', game.ItemHardRock, "game.ItemHardRock"},
+	{'G', game.ItemGoldRock, "game.ItemGoldRock"},
+	{'F', game.ItemFood, "game.ItemFood"},
+}
+
+func cellOf(c byte) (Cell, bool) {
+	for _, cell := range Cells {
+		if cell.Char == c {
+			return cell, true
+		}
+	}
+	return Cell{}, false
+}
+
+// rowCount is the number of grid rows: two lines with two lanes each.
+const rowCount = 4
+
+// Chunk is a parsed chunk file.
+type Chunk struct {
+	File        string // Path the chunk was read from (for messages)
+	Description []string
+	Template    generators.ChunkTemplate
+}
+
+// Error is a problem at a line of a chunk file.
+type Error struct {
+	File string
+	Line int // 0 when the problem is not at a particular line
+	Msg  string
+}
+
+func (e *Error) Error() string {
+	if e.Line == 0 {
+		return fmt.Sprintf("%s: %s", e.File, e.Msg)
+	}
+	return fmt.Sprintf("%s:%d: %s", e.File, e.Line, e.Msg)
+}
+
+// Parse reads one chunk file. file is only used in error messages.
+func Parse(file string, r io.Reader) (*Chunk, error) {
+	c := &Chunk{File: file}
+	c.Template.MinLevel = 1
+	c.Template.Weight = 1
+
+	errorf := func(line int, format string, args ...any) error {
+		return &Error{File: file, Line: line, Msg: fmt.Sprintf(format, args...)}
+	}
+
+	var rows []string
+	seen := map[string]bool{}
+	scanner := bufio.NewScanner(r)
+	for n := 1; scanner.Scan(); n++ {
+		text := strings.TrimSpace(scanner.Text())
+		switch {
+		case text == "":
+			continue
+		case strings.HasPrefix(text, "#"):
+			if len(seen) == 0 && len(rows) == 0 {
+				c.Description = append(c.Description, strings.TrimSpace(text[1:]))
+			}
+			continue
+		}
+
+		key, value, isHeader := strings.Cut(text, ":")
+		if isHeader {
+			if len(rows) > 0 {
+				return nil, errorf(n, "header %q after the grid", key)
+			}
+			key = strings.TrimSpace(key)
+			value = strings.TrimSpace(value)
+			if seen[key] {
+				return nil, errorf(n, "duplicate header %q", key)
+			}
+			seen[key] = true
+			if err := c.setHeader(key, value); err != nil {
+				return nil, errorf(n, "%v", err)
+			}
+			continue
+		}
+
+		if len(rows) == rowCount {
+			return nil, errorf(n, "more than %d grid rows", rowCount)
+		}
+		for i := 0; i < len(text); i++ {
+			if _, ok := cellOf(text[i]); !ok {
+				return nil, errorf(n, "unknown cell %q in column %d (want one of %s)", text[i], i+1, cellChars())
+			}
+		}
+		if len(rows) > 0 && len(text) != len(rows[0]) {
+			return nil, errorf(n, "row has %d columns, the first row has %d", len(text), len(rows[0]))
+		}
+		rows = append(rows, text)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, &Error{File: file, Msg: err.Error()}
+	}
+
+	if c.Template.Name == "" {
+		return nil, errorf(0, "missing header \"name\"")
+	}
+	if len(rows) != rowCount {
+		return nil, errorf(0, "grid has %d rows, want %d (line 0 lane 0, line 0 lane 1, line 1 lane 0, line 1 lane 1)", len(rows), rowCount)
+	}
+	if c.Template.MaxLevel != 0 && c.Template.MaxLevel < c.Template.MinLevel {
+		return nil, errorf(0, "max-level %d is below min-level %d", c.Template.MaxLevel, c.Template.MinLevel)
+	}
+
+	for i, row := range rows {
+		kinds := make([]game.ItemKind, len(row))
+		for col := 0; col < len(row); col++ {
+			cell, _ := cellOf(row[col])
+			kinds[col] = cell.Kind
+		}
+		c.Template.Grid[i/2][i%2] = kinds
+	}
+	return c, nil
+}
+
+func (c *Chunk) setHeader(key, value string) error {
+	switch key {
+	case "name":
+		if value == "" {
+			return fmt.Errorf("empty name")
+		}
+		c.Template.Name = value
+		return nil
+	case "min-level":
+		return parseInt(key, value, 1, &c.Template.MinLevel)
+	case "max-level":
+		return parseInt(key, value, 0, &c.Template.MaxLevel)
+	case "weight":
+		return parseInt(key, value, 1, &c.Template.Weight)
+	}
+	return fmt.Errorf("unknown header %q", key)
+}
+
+func parseInt(key, value string, minValue int, dst *int) error {
+	v, err := strconv.Atoi(value)
+	if err != nil || v < minValue {
+		return fmt.Errorf("%s must be an integer >= %d, got %q", key, minValue, value)
+	}
+	*dst = v
+	return nil
+}
+
+func cellChars() string {
+	var b strings.Builder
+	for i, cell := range Cells {
+		if i > 0 {
+			b.WriteString(", ")
+		}
+		fmt.Fprintf(&b, "%c", cell.Char)
+	}
+	return b.String()
+}
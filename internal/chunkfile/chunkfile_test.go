@@ -0,0 +1,97 @@
+package chunkfile
+
+import (
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+
+	"GolangGame251130/internal/game"
+	"GolangGame251130/internal/game/generators"
+)
+
+const validChunk = `# A short relay
+# over two lines
+name: relay
+min-level: 2
+max-level: 5
+weight: 3
+
+R.F
+.H.
+
+G..
+..R
+`
+
+func TestParse(t *testing.T) {
+	c, err := Parse("relay.chunk", strings.NewReader(validChunk))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := []string{"A short relay", "over two lines"}; !reflect.DeepEqual(c.Description, want) {
+		t.Errorf("description %q, want %q", c.Description, want)
+	}
+
+	e := generators.Empty
+	want := generators.ChunkTemplate{
+		Name:     "relay",
+		MinLevel: 2,
+		MaxLevel: 5,
+		Weight:   3,
+		Grid: [2][2][]game.ItemKind{
+			{{game.ItemRock, e, game.ItemFood}, {e, game.ItemHardRock, e}},
+			{{game.ItemGoldRock, e, e}, {e, e, game.ItemRock}},
+		},
+	}
+	if !reflect.DeepEqual(c.Template, want) {
+		t.Errorf("template %+v, want %+v", c.Template, want)
+	}
+}
+
+func TestParseDefaults(t *testing.T) {
+	c, err := Parse("a.chunk", strings.NewReader("name: a\n.\n.\n.\n.\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Template.MinLevel != 1 || c.Template.MaxLevel != 0 || c.Template.Weight != 1 {
+		t.Errorf("levels %d-%d weight %d, want 1-0 weight 1", c.Template.MinLevel, c.Template.MaxLevel, c.Template.Weight)
+	}
+}
+
+func TestParseErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		src  string
+		line int
+		msg  string
+	}{
+		{"unknown cell", "name: a\nR.\n.X\n..\n..\n", 3, `unknown cell 'X' in column 2`},
+		{"short row", "name: a\nR..\n..\n...\n...\n", 3, "row has 2 columns, the first row has 3"},
+		{"too few rows", "name: a\nR.\n..\n..\n", 0, "grid has 3 rows, want 4"},
+		{"too many rows", "name: a\nR.\n..\n..\n..\n..\n", 6, "more than 4 grid rows"},
+		{"missing name", "weight: 2\nR.\n..\n..\n..\n", 0, `missing header "name"`},
+		{"empty name", "name:\nR.\n..\n..\n..\n", 1, "empty name"},
+		{"header after grid", "name: a\nR.\nweight: 2\n", 3, `header "weight" after the grid`},
+		{"duplicate header", "name: a\nname: b\n", 2, `duplicate header "name"`},
+		{"unknown header", "name: a\ncolor: red\n", 2, `unknown header "color"`},
+		{"bad weight", "name: a\nweight: 0\n", 2, `weight must be an integer >= 1, got "0"`},
+		{"max below min", "name: a\nmin-level: 3\nmax-level: 2\nR.\n..\n..\n..\n", 0, "max-level 2 is below min-level 3"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := Parse("a.chunk", strings.NewReader(tt.src))
+			var perr *Error
+			if !errors.As(err, &perr) {
+				t.Fatalf("error %v, want a *chunkfile.Error", err)
+			}
+			if perr.File != "a.chunk" || perr.Line != tt.line {
+				t.Errorf("at %s:%d, want a.chunk:%d", perr.File, perr.Line, tt.line)
+			}
+			if !strings.Contains(perr.Msg, tt.msg) {
+				t.Errorf("message %q, want %q", perr.Msg, tt.msg)
+			}
+		})
+	}
+}
@@ -0,0 +1,66 @@
+package chunkfile
+
+import (
+	"bytes"
+	"fmt"
+	"go/format"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"GolangGame251130/internal/game"
+)
+
+// Generate returns the Go source declaring generators.DefaultChunkTemplates
+// for the chunks. Chunks are sorted by file name (without the directory) so
+// the output is the same wherever the tool is run from.
+func Generate(chunks []*Chunk) ([]byte, error) {
+	sorted := append([]*Chunk(nil), chunks...)
+	sort.Slice(sorted, func(i, j int) bool { return filepath.Base(sorted[i].File) < filepath.Base(sorted[j].File) })
+
+	var b bytes.Buffer
+	b.WriteString("// Code generated by cmd/chunkgen from chunks/*.chunk. DO NOT EDIT.\n\n")
+	b.WriteString("package generators\n\n")
+	b.WriteString("import (\n\t\"GolangGame251130/internal/game\"\n)\n\n")
+	b.WriteString("// DefaultChunkTemplates are the set-pieces PathGenerator splices in by default,\n")
+	b.WriteString("// generated from the chunk files in chunks/.\n")
+	b.WriteString("var DefaultChunkTemplates = []ChunkTemplate{\n")
+	for _, c := range sorted {
+		t := &c.Template
+		b.WriteString("{\n")
+		fmt.Fprintf(&b, "// %s\n", filepath.Base(c.File))
+		for _, d := range c.Description {
+			fmt.Fprintf(&b, "// %s\n", d)
+		}
+		fmt.Fprintf(&b, "Name: %q,\nMinLevel: %d,\nMaxLevel: %d,\nWeight: %d,\n", t.Name, t.MinLevel, t.MaxLevel, t.Weight)
+		b.WriteString("Grid: [2][2][]game.ItemKind{\n")
+		for line := 0; line < 2; line++ {
+			b.WriteString("{\n")
+			for lane := 0; lane < 2; lane++ {
+				idents := make([]string, len(t.Grid[line][lane]))
+				for i, kind := range t.Grid[line][lane] {
+					idents[i] = identOf(kind)
+				}
+				fmt.Fprintf(&b, "{%s},\n", strings.Join(idents, ", "))
+			}
+			b.WriteString("},\n")
+		}
+		b.WriteString("},\n},\n")
+	}
+	b.WriteString("}\n")
+
+	src, err := format.Source(b.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated source: %v", err)
+	}
+	return src, nil
+}
+
+func identOf(kind game.ItemKind) string {
+	for _, cell := range Cells {
+		if cell.Kind == kind {
+			return cell.Ident
+		}
+	}
+	return fmt.Sprintf("game.ItemKind(%d)", kind)
+}
@@ -0,0 +1,64 @@
+package chunkfile
+
+import (
+	"strings"
+	"testing"
+)
+
+const tinyChunk = `# Two rocks
+name: tiny
+max-level: 3
+
+R.
+.H
+
+F.
+.G
+`
+
+// tinySource is the source Generate writes for tinyChunk.
+const tinySource = `// Code generated by cmd/chunkgen from chunks/*.chunk. DO NOT EDIT.
+
+package generators
+
+import (
+	"GolangGame251130/internal/game"
+)
+
+// DefaultChunkTemplates are the set-pieces PathGenerator splices in by default,
+// generated from the chunk files in chunks/.
+var DefaultChunkTemplates = []ChunkTemplate{
+	{
+		// tiny.chunk
+		// Two rocks
+		Name:     "tiny",
+		MinLevel: 1,
+		MaxLevel: 3,
+		Weight:   1,
+		Grid: [2][2][]game.ItemKind{
+			{
+				{game.ItemRock, Empty},
+				{Empty, game.ItemHardRock},
+			},
+			{
+				{game.ItemFood, Empty},
+				{Empty, game.ItemGoldRock},
+			},
+		},
+	},
+}
+`
+
+func TestGenerate(t *testing.T) {
+	c, err := Parse("chunks/tiny.chunk", strings.NewReader(tinyChunk))
+	if err != nil {
+		t.Fatal(err)
+	}
+	src, err := Generate([]*Chunk{c})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(src) != tinySource {
+		t.Errorf("generated source:\n%s\nwant:\n%s", src, tinySource)
+	}
+}
@@ -1,23 +1,19 @@
 package game
 
-import (
-	"github.com/sorucoder/tic80"
-)
-
 // DrawOutlinedText は枠線付きテキストを描画する
-func DrawOutlinedText(text string, x, y int, color, outlineColor int) {
+func DrawOutlinedText(r Renderer, text string, x, y int, color, outlineColor int) {
 	// 枠線（上下左右斜め）
-	tic80.Print(text, x-1, y, tic80.NewPrintOptions().SetColor(outlineColor))
-	tic80.Print(text, x+1, y, tic80.NewPrintOptions().SetColor(outlineColor))
-	tic80.Print(text, x, y-1, tic80.NewPrintOptions().SetColor(outlineColor))
-	tic80.Print(text, x, y+1, tic80.NewPrintOptions().SetColor(outlineColor))
+	r.Print(text, x-1, y, PrintOptions{Color: outlineColor})
+	r.Print(text, x+1, y, PrintOptions{Color: outlineColor})
+	r.Print(text, x, y-1, PrintOptions{Color: outlineColor})
+	r.Print(text, x, y+1, PrintOptions{Color: outlineColor})
 
 	// 本体
-	tic80.Print(text, x, y, tic80.NewPrintOptions().SetColor(color))
+	r.Print(text, x, y, PrintOptions{Color: color})
 }
 
 // DrawPoppingText は波打つテキストを描画する
-func DrawPoppingText(text string, x, y int, color, outlineColor int, time float32) {
+func DrawPoppingText(r Renderer, text string, x, y int, color, outlineColor int, time float32) {
 	width := 6 // 1文字あたりの概算幅（TIC-80のフォントサイズによる）
 
 	for i, char := range text {
@@ -28,7 +24,7 @@ func DrawPoppingText(text string, x, y int, color, outlineColor int, time float3
 		curX := x + i*width
 		curY := y + int(offsetY)
 
-		DrawOutlinedText(charStr, curX, curY, color, outlineColor)
+		DrawOutlinedText(r, charStr, curX, curY, color, outlineColor)
 	}
 }
 
@@ -41,12 +37,12 @@ var bayerMatrix = [16]float32{
 }
 
 // DrawDitheredBlack はディザリングのかかった黒を描画する
-func DrawDitheredBlack(alpha float32) {
+func DrawDitheredBlack(r Renderer, alpha float32) {
 	if alpha <= 0.0 {
 		return
 	}
 	if alpha >= 1.0 {
-		tic80.Cls(0)
+		r.Cls(0)
 		return
 	}
 
@@ -55,7 +51,7 @@ func DrawDitheredBlack(alpha float32) {
 			threshold := bayerMatrix[(x%4)+(y%4)*4]
 
 			if alpha > threshold {
-				tic80.Pix(x, y, 0)
+				r.Pix(x, y, 0)
 			}
 		}
 	}
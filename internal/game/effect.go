@@ -1,13 +1,9 @@
 package game
 
-import (
-	"github.com/sorucoder/tic80"
-)
-
 // Effect は視覚効果のインターフェース
 type Effect interface {
 	Update(dt float32) bool // 戻り値: つづけるならtrue, 終了ならfalse
-	Draw(camera *Camera)
+	Draw(r Renderer, camera *Camera)
 	OnCoordinateReset(offsetX float32)
 }
 
@@ -36,9 +32,9 @@ func (em *EffectManager) Update(dt float32) {
 	em.effects = activeEffects
 }
 
-func (em *EffectManager) Draw(camera *Camera) {
+func (em *EffectManager) Draw(r Renderer, camera *Camera) {
 	for _, e := range em.effects {
-		e.Draw(camera)
+		e.Draw(r, camera)
 	}
 }
 
@@ -72,13 +68,13 @@ func (e *PoppingTextEffect) Update(dt float32) bool {
 	return e.lifeTime < e.maxLife
 }
 
-func (e *PoppingTextEffect) Draw(camera *Camera) {
+func (e *PoppingTextEffect) Draw(r Renderer, camera *Camera) {
 	if e.lifeTime > e.maxLife*0.8 && int(e.lifeTime*20)%2 == 0 {
 		return
 	}
 
 	screenPos := camera.WorldToScreen(e.position)
-	DrawPoppingText(e.text, Round(screenPos.X), Round(screenPos.Y), e.color, 0, e.lifeTime)
+	DrawPoppingText(r, e.text, Round(screenPos.X), Round(screenPos.Y), e.color, 0, e.lifeTime)
 }
 
 func (e *PoppingTextEffect) OnCoordinateReset(offsetX float32) {
@@ -94,9 +90,9 @@ type ParticleEffect struct {
 	maxLife  float32
 }
 
-func NewParticleEffect(x, y float32, color int) *ParticleEffect {
-	vx := (RandomFloat32() - 0.5) * 60
-	vy := (RandomFloat32()-0.5)*60 - 30
+func NewParticleEffect(rng *RNG, x, y float32, color int) *ParticleEffect {
+	vx := (rng.Float32() - 0.5) * 60
+	vy := (rng.Float32()-0.5)*60 - 30
 	return &ParticleEffect{
 		position: Vector2d{x, y},
 		velocity: Vector2d{vx, vy},
@@ -113,9 +109,9 @@ func (e *ParticleEffect) Update(dt float32) bool {
 	return e.lifeTime < e.maxLife
 }
 
-func (e *ParticleEffect) Draw(camera *Camera) {
+func (e *ParticleEffect) Draw(r Renderer, camera *Camera) {
 	screenPos := camera.WorldToScreen(e.position)
-	tic80.Pix(Round(screenPos.X), Round(screenPos.Y), e.color)
+	r.Pix(Round(screenPos.X), Round(screenPos.Y), e.color)
 }
 
 func (e *ParticleEffect) OnCoordinateReset(offsetX float32) {
@@ -150,7 +146,7 @@ func (e *TransferEffect) Update(dt float32) bool {
 	return e.lifeTime < e.maxLife
 }
 
-func (e *TransferEffect) Draw(camera *Camera) {
+func (e *TransferEffect) Draw(r Renderer, camera *Camera) {
 	startPos := camera.WorldToScreen(e.start)
 	endPos := camera.WorldToScreen(e.end)
 
@@ -177,7 +173,7 @@ func (e *TransferEffect) Draw(camera *Camera) {
 
 		size := 8
 
-		tic80.Rect(Round(x)-size/2, Round(top), size, Round(bottom-top), gradient[i])
+		r.Rect(Round(x)-size/2, Round(top), size, Round(bottom-top), gradient[i])
 	}
 
 	centerX := (startPos.X + endPos.X) / 2
@@ -185,7 +181,7 @@ func (e *TransferEffect) Draw(camera *Camera) {
 
 	colorIndex := int(t * float32(count-1))
 
-	DrawOutlinedText("<-", Round(centerX)-4, Round(centerY)-3, gradient[colorIndex], 0)
+	DrawOutlinedText(r, "<-", Round(centerX)-4, Round(centerY)-3, gradient[colorIndex], 0)
 }
 
 func (e *TransferEffect) OnCoordinateReset(offsetX float32) {
@@ -217,7 +213,7 @@ func (e *HoleEffect) Update(dt float32) bool {
 	return e.lifeTime < e.maxLife
 }
 
-func (e *HoleEffect) Draw(camera *Camera) {
+func (e *HoleEffect) Draw(r Renderer, camera *Camera) {
 	screenPos := camera.WorldToScreen(e.position)
 	if screenPos.X < -20 || screenPos.X > 260 {
 		return
@@ -242,7 +238,7 @@ func (e *HoleEffect) Draw(camera *Camera) {
 		currentRadius = 0
 	}
 
-	tic80.Circ(Round(screenPos.X), Round(screenPos.Y), int(currentRadius), e.color)
+	r.Circ(Round(screenPos.X), Round(screenPos.Y), int(currentRadius), e.color)
 }
 
 func (e *HoleEffect) OnCoordinateReset(offsetX float32) {
@@ -0,0 +1,128 @@
+package game
+
+// Event はゲーム中に起きた出来事（EventBus で配信される）
+type Event interface {
+	gameplayEvent()
+}
+
+// RockBroken はツルハシでRockを壊したときのイベント
+type RockBroken struct {
+	Kind     ItemKind
+	Line     int
+	Position Vector2d // 壊したプレイヤーの位置
+	Score    int      // 加算するスコア
+}
+
+// GoldRockBroken はツルハシでGoldRock（ボーナススコアのある岩）を壊したときのイベント
+type GoldRockBroken struct {
+	Kind     ItemKind
+	Line     int
+	Position Vector2d
+	Score    int
+}
+
+// HardRockHit は障害物にぶつかってダメージを受けたときのイベント
+// （HardRock、またはツルハシを持たずにRock/GoldRockにぶつかった場合）
+type HardRockHit struct {
+	Kind     ItemKind
+	Line     int
+	Position Vector2d
+	Energy   float32 // エネルギーの増減（負の値）
+}
+
+// FoodEaten はFoodを取ったときのイベント
+type FoodEaten struct {
+	Kind     ItemKind
+	Line     int
+	Position Vector2d
+	Energy   float32 // エネルギーの増減
+}
+
+// PowerUpCollected はパワーアップを取ったときのイベント
+type PowerUpCollected struct {
+	Kind     ItemKind
+	PowerUp  PowerUpKind
+	Line     int
+	Position Vector2d
+}
+
+// ShieldAbsorbed はシールドがHardRockへの衝突を防いだときのイベント
+type ShieldAbsorbed struct {
+	Kind     ItemKind // ぶつかったアイテム
+	Line     int
+	Position Vector2d
+}
+
+// LevelUp はゴールに到達してレベルが上がったときのイベント
+type LevelUp struct {
+	Level   int
+	CameraX float32 // レベルアップ時のカメラX座標（演出の表示位置）
+	Score   int     // ボーナススコア
+}
+
+// PickaxeTransferred はツルハシを受け渡したときのイベント
+type PickaxeTransferred struct {
+	From, To       int      // ライン番号
+	FromPos, ToPos Vector2d // 受け渡し元・先のツルハシの位置
+}
+
+// ItemResolved はアイテムがラインから取り除かれるときのイベント
+// （取った・壊した・ぶつかった・触れずに画面外へ流れた）
+type ItemResolved struct {
+	Item    Item
+	Line    int
+	Outcome ItemOutcome
+}
+
+// GameOver はゲームオーバーになったときのイベント
+type GameOver struct {
+	Cause GameOverCause
+	Score int
+	Level int
+}
+
+func (RockBroken) gameplayEvent()         {}
+func (GoldRockBroken) gameplayEvent()     {}
+func (HardRockHit) gameplayEvent()        {}
+func (FoodEaten) gameplayEvent()          {}
+func (PowerUpCollected) gameplayEvent()   {}
+func (ShieldAbsorbed) gameplayEvent()     {}
+func (LevelUp) gameplayEvent()            {}
+func (PickaxeTransferred) gameplayEvent() {}
+func (ItemResolved) gameplayEvent()       {}
+func (GameOver) gameplayEvent()           {}
+
+// EventListener はイベントを受け取る
+type EventListener interface {
+	OnEvent(ev Event)
+}
+
+// EventListenerFunc は関数を EventListener として使うためのアダプタ
+type EventListenerFunc func(ev Event)
+
+func (f EventListenerFunc) OnEvent(ev Event) {
+	f(ev)
+}
+
+// EventBus はイベントを登録順にリスナーへ配信する
+type EventBus struct {
+	listeners []EventListener
+}
+
+func NewEventBus() *EventBus {
+	return &EventBus{
+		listeners: []EventListener{},
+	}
+}
+
+// Subscribe はリスナーを登録する
+func (b *EventBus) Subscribe(l EventListener) {
+	b.listeners = append(b.listeners, l)
+}
+
+// Publish はイベントを全てのリスナーに配信する
+func (b *EventBus) Publish(ev Event) {
+	for _, l := range b.listeners {
+		l.OnEvent(ev)
+	}
+}
@@ -1,61 +1,153 @@
 package game
 
-import (
-	"github.com/sorucoder/tic80"
-)
-
 type Updatable interface {
 	Update(dt float32)
 }
 
 type Drawable interface {
-	Draw(camera *Camera)
+	Draw(r Renderer, camera *Camera)
 }
 
+// Collidable は衝突したときに相手に応じて反応するもの
 type Collidable interface {
 	OnCollide(collidable Collidable)
 }
 
+// GameOverCause はゲームオーバーの原因
+type GameOverCause int
+
+const (
+	GameOverNone        GameOverCause = iota
+	GameOverEnergyDrain               // 時間経過によるエネルギー切れ
+	GameOverDamage                    // 障害物への衝突によるエネルギー切れ
+)
+
+// levelUpBonus はレベルアップ時のボーナススコア（アイテムの増減は ItemDefs）
+const levelUpBonus = 1000
+
+// initialEnergy はゲーム開始時のエネルギー
+const initialEnergy = 100
+
+// EnergyDrainRate は時間経過で1秒あたりに減るエネルギー
+const EnergyDrainRate = 5
+
 type Game struct {
-	score         float32 // スコア（時間経過で増加）
+	BaseScene
+
+	renderer      Renderer
+	input         Input
+	audio         Audio
+	storage       Storage
+	logger        Logger
+	seed          uint32          // このプレイの乱数シード
+	levelRNG      *RNG            // レベル生成用の乱数
+	fxRNG         *RNG            // 演出用の乱数（レベル生成に影響しない）
+	recorder      *ReplayRecorder // 入力の記録（リプレイ再生中はnil）
+	replayer      *ReplayPlayer   // リプレイ再生中の入力元
+	score         float32         // スコア（時間経過で増加）
 	speed         float32
 	lines         []*Line
 	camera        Camera
+	prevCameraX   float32 // 前回の更新時のカメラX座標（描画の外挿用）
+	drawAlpha     float32 // 前回の更新から経過したステップの割合 [0, 1)
 	spawner       LevelGenerator
-	genFactory    GeneratorFactory // タイトル画面に戻るために必要
-	pickaxeOwner  int              // ツルハシの所持者 (0=プレイヤー1, 1=プレイヤー2)
-	energy        float32          // エネルギー（ライフ）
-	gameOver      bool             // ゲームオーバーフラグ
-	goalDistance  float32          // ゴールまでの距離
-	totalDistance float32          // 実際の総移動距離
-	level         int              // 現在のレベル（周回数 + 1）
-	effects          *EffectManager
-	bgEffects        *EffectManager
-	sceneManager     *SceneManager
-	gameOverTimer    float32 // ゲームオーバー後の経過時間
-	canReturnToTitle bool    // タイトルに戻れるかどうか
-}
-
-func NewGame(genFactory GeneratorFactory) *Game {
+	mode          GameMode      // 遊んでいるモード（リスタート用）
+	modes         []GameMode    // タイトル画面に戻るために必要
+	pickaxeOwner  int           // ツルハシの所持者 (0=プレイヤー1, 1=プレイヤー2)
+	energy        float32       // エネルギー（ライフ）
+	gameOver      bool          // ゲームオーバーフラグ
+	gameOverCause GameOverCause // ゲームオーバーの原因
+	goalDistance  float32       // ゴールまでの距離
+	totalDistance float32       // 実際の総移動距離
+	level         int           // 現在のレベル（周回数 + 1）
+	effects       *EffectManager
+	bgEffects     *EffectManager
+	eventBus      *EventBus
+	powerUps      [powerUpCount]float32 // パワーアップの残り時間（秒）
+	powerUpMax    [powerUpCount]float32 // 発動時の効果時間（HUDのバー用）
+	stats         GameStats
+	sceneManager  *SceneManager
+	scoreHidden   bool // スコア表示を隠す（ゲームオーバー演出でスコアを動かすため）
+	debugVisible  bool // レベル生成器のデバッグ表示（↓ボタンで切り替え）
+	highScoreRank int  // 今回のハイスコア順位（0始まり, ランク外は-1）
+	bestScore     int  // ハイスコア表の最高スコア
+}
+
+// NewGame はモードのレベル生成器とシード（通常は共有乱数から選んだ新しいシード）でゲームを作成する
+func NewGame(platform Platform, mode GameMode) *Game {
+	return newGameWithMode(platform, mode, mode.newSeed())
+}
+
+// restart は同じモードとシードで最初からやり直すゲームを作成する
+// レベル生成器は作り直さずに Reset で初期状態に戻して引き継ぐ
+func (g *Game) restart(platform Platform) *Game {
+	spawner := g.spawner
+	spawner.Reset(g.seed)
+	mode := g.mode
+	mode.Factory = func(*RNG) LevelGenerator { return spawner }
+	newGame := newGameWithMode(platform, mode, g.seed)
+	newGame.mode = g.mode
+	newGame.modes = g.modes
+	return newGame
+}
+
+// newGameWithMode は指定したシードでモードのゲームを作成する
+func newGameWithMode(platform Platform, mode GameMode, seed uint32) *Game {
+	g := NewGameWithSeed(platform, mode.Factory, seed)
+	g.mode = mode
+	g.recorder.replay.Generator = mode.Generator
+	return g
+}
+
+// NewReplayGame はリプレイの入力を再生するゲームを作成する
+func NewReplayGame(platform Platform, genFactory GeneratorFactory, replay *Replay) *Game {
+	g := NewGameWithSeed(platform, genFactory, replay.Seed)
+	g.recorder = nil
+	g.replayer = NewReplayPlayer(replay)
+	return g
+}
+
+// NewGameWithSeed は指定したシードでゲームを作成する（同じシードと入力なら同じ展開になる）
+func NewGameWithSeed(platform Platform, genFactory GeneratorFactory, seed uint32) *Game {
+	levelRNG := NewRNG(seed)
+	// 演出用はシードから別の系列を作る（XorShiftは0を受け付けないのでNewRNGが補正する）
+	fxRNG := NewRNG(seed ^ 0x9e3779b9)
+
 	g := &Game{
+		renderer:      platform.Renderer,
+		input:         platform.Input,
+		audio:         platform.Audio,
+		storage:       platform.Storage,
+		logger:        platform.Logger,
+		seed:          seed,
+		levelRNG:      levelRNG,
+		fxRNG:         fxRNG,
+		recorder:      NewReplayRecorder(seed),
 		score:         0,
 		speed:         64, // スピードを32から64に増加
 		lines:         []*Line{},
 		camera:        Camera{Position: Vector2d{0, 0}, Scale: 1.0},
-		spawner:       genFactory(),
-		genFactory:    genFactory,
-		pickaxeOwner:  0,   // 初期はプレイヤー1がツルハシを所持
-		energy:        100, // 初期エネルギー
+		spawner:       genFactory(levelRNG),
+		mode:          GameMode{Factory: genFactory},
+		pickaxeOwner:  0, // 初期はプレイヤー1がツルハシを所持
+		energy:        initialEnergy,
 		gameOver:      false,
 		goalDistance:  3000, // ゴール地点 (3000ピクセル)
-		totalDistance:    0,
-		level:            1,
-		effects:          NewEffectManager(),
-		bgEffects:        NewEffectManager(),
-		gameOverTimer:    0,
-		canReturnToTitle: false,
+		totalDistance: 0,
+		level:         1,
+		effects:       NewEffectManager(),
+		bgEffects:     NewEffectManager(),
+		eventBus:      NewEventBus(),
+		highScoreRank: -1,
 	}
 
+	// 標準のリスナー（スコア → 効果音 → 演出 → 集計 → レベル生成器の順に配信）
+	g.eventBus.Subscribe(&scoringListener{game: g})
+	g.eventBus.Subscribe(&audioListener{audio: g.audio})
+	g.eventBus.Subscribe(&effectsListener{game: g})
+	g.eventBus.Subscribe(&g.stats)
+	g.eventBus.Subscribe(&generatorListener{game: g})
+
 	// 上下2つのラインを作成
 	g.lines = append(g.lines, NewLine(g, 0)) // 上ライン
 	g.lines = append(g.lines, NewLine(g, 1)) // 下ライン
@@ -65,20 +157,60 @@ func NewGame(genFactory GeneratorFactory) *Game {
 
 func (g *Game) OnEnter() {
 	// BGM 1 (Game) Loop
-	tic80.Music(tic80.NewMusicOptions().SetTrack(1))
+	g.audio.Music(1)
 }
 
-func (g *Game) SetGameOver() {
+// OnPause はポーズなどで上にシーンが積まれたときにBGMを止める
+func (g *Game) OnPause() {
+	g.audio.Music(-1)
+}
+
+// OnResume はポーズから戻ったときにBGMを再開する
+func (g *Game) OnResume() {
+	if !g.gameOver {
+		g.audio.Music(1)
+	}
+}
+
+func (g *Game) SetGameOver(cause GameOverCause) {
 	if g.gameOver {
 		return
 	}
 	g.gameOver = true
-	// Stop music
-	tic80.Music(tic80.NewMusicOptions().SetTrack(-1))
-	tic80.Sfx(tic80.NewSoundEffectOptions().SetId(10).SetNote(40))
+	g.gameOverCause = cause
+
+	// ハイスコア表に登録（リプレイ再生では記録しない）
+	if g.replayer == nil {
+		table := LoadHighScores(g.storage)
+		g.highScoreRank = table.Insert(HighScoreEntry{
+			Score:    int(g.score),
+			Level:    g.level,
+			Distance: int(g.GetDistance()),
+			Seed:     g.seed,
+		})
+		if g.highScoreRank >= 0 {
+			table.Save(g.storage)
+		}
+		g.bestScore = table.Best()
+	}
+
+	// バグ報告用にリプレイをコンソールへ出力
+	if g.recorder != nil && g.logger != nil {
+		g.logger.Trace("REPLAY " + EncodeReplayText(g.recorder.Finish(g.score)))
+	}
+
+	g.eventBus.Publish(GameOver{Cause: cause, Score: int(g.score), Level: g.level})
+
+	if g.sceneManager != nil {
+		g.sceneManager.PushScene(NewGameOverScene(g.sceneManager, g), true)
+	}
 }
 
+// Speed は現在のスクロール速度を返す（スピードバースト中は倍率がかかる）
 func (g *Game) Speed() float32 {
+	if g.HasPowerUp(PowerUpSpeed) {
+		return g.speed * SpeedBurstMultiplier
+	}
 	return g.speed
 }
 
@@ -86,37 +218,110 @@ func (g *Game) GetLevel() int {
 	return g.level
 }
 
+// SetLevel はレベルとそれに応じたスピードを直接設定する（レベル生成の検証やデバッグ用）
+// レベルアップの演出やボーナスは無いが、レベル生成器には通知する
+func (g *Game) SetLevel(level int) {
+	g.level = level
+	g.speed = LevelSpeed(level)
+	g.spawner.OnLevelUp(level)
+}
+
+// LevelSpeed はレベルごとのスピード（レベルごとに +8）
+func LevelSpeed(level int) float32 {
+	return 64.0 + float32(level-1)*8.0
+}
+
 func (g *Game) GetLines() []*Line {
 	return g.lines
 }
 
+func (g *Game) GetScore() float32 {
+	return g.score
+}
+
+func (g *Game) GetEnergy() float32 {
+	return g.energy
+}
+
+// GetEventBus はゲームプレイイベントの配信元を返す（リスナーの追加用）
+func (g *Game) GetEventBus() *EventBus {
+	return g.eventBus
+}
+
+// GetStats はこのプレイのイベント集計を返す
+func (g *Game) GetStats() GameStats {
+	return g.stats
+}
+
+func (g *Game) GetPickaxeOwner() int {
+	return g.pickaxeOwner
+}
+
+// GetDistance はゲーム開始からの総移動距離を返す
+func (g *Game) GetDistance() float32 {
+	return float32(g.level-1)*g.goalDistance + g.totalDistance
+}
+
+func (g *Game) IsGameOver() bool {
+	return g.gameOver
+}
+
+func (g *Game) GetGameOverCause() GameOverCause {
+	return g.gameOverCause
+}
+
 func (g *Game) GetCameraX() float32 {
 	return g.camera.Position.X
 }
 
-func (g *Game) Update(dt float32) {
-	// ゲームオーバーまたはクリア時は更新しない
-	if g.gameOver {
-		g.gameOverTimer += dt
+// GetSeed はこのプレイの乱数シードを返す
+func (g *Game) GetSeed() uint32 {
+	return g.seed
+}
 
-		// 約1.5秒後にタイトルに戻れるようにする
-		if g.gameOverTimer > 1.5 {
-			g.canReturnToTitle = true
-		}
+// GetReplay はここまでの入力記録を返す（リプレイ再生中はnil）
+func (g *Game) GetReplay() *Replay {
+	if g.recorder == nil {
+		return nil
+	}
+	return g.recorder.Finish(g.score)
+}
 
-		// ゲームオーバー時にボタン入力でタイトルへ
-		if g.canReturnToTitle {
-			if tic80.Btnp(tic80.BUTTON_A, 60000, 60000) || tic80.Btnp(tic80.BUTTON_B, 60000, 60000) {
-				if g.sceneManager != nil {
-					g.sceneManager.ChangeScene(NewTitleScene(g.sceneManager, g.genFactory))
-				}
-			}
-		}
+// IsReplayFinished はリプレイの入力を全て再生し終えたかを返す
+func (g *Game) IsReplayFinished() bool {
+	return g.replayer != nil && g.replayer.Done()
+}
+
+// readButtons はこのフレームの入力を読み取る（リプレイ再生中は記録された入力）
+func (g *Game) readButtons() ButtonState {
+	if g.replayer != nil && !g.replayer.Done() {
+		return g.replayer.Next()
+	}
+	buttons := ReadButtons(g.input)
+	if g.recorder != nil && !g.gameOver {
+		g.recorder.Record(buttons)
+	}
+	return buttons
+}
+
+// SetDrawAlpha は固定ステップ間の補間係数を設定する
+func (g *Game) SetDrawAlpha(alpha float32) {
+	g.drawAlpha = alpha
+}
+
+func (g *Game) Update(dt float32) {
+	g.prevCameraX = g.camera.Position.X
+	buttons := g.readButtons()
+
+	// ゲームオーバー後は更新しない（演出はGameOverSceneが行う）
+	if g.gameOver {
 		return
 	}
 
+	g.updatePowerUps(dt)
+
 	// 総移動距離の更新
-	g.totalDistance += g.speed * dt
+	g.totalDistance += g.Speed() * dt
 
 	// ゴール判定 (totalDistanceを使用)
 	// 無限ループ機能: ゴールに到達したら距離をリセットして続行
@@ -126,43 +331,40 @@ func (g *Game) Update(dt float32) {
 
 		// レベルアップ処理
 		// スピード上昇: レベルごとに +8
-		g.speed = 64.0 + float32(g.level-1)*8.0
-
-		// エフェクト表示 (画面中央付近に)
-		centerX := g.camera.Position.X
-		g.AddEffect(NewPoppingTextEffect("LEVEL "+intToString(g.level), centerX, 60, 12))
-
-		// SFX: Level Up (24)
-		tic80.Sfx(tic80.NewSoundEffectOptions().SetId(24).SetNote(52))
+		g.speed = LevelSpeed(g.level)
 
 		// レベルアップボーナススコア
-		g.score += 1000
+		g.eventBus.Publish(LevelUp{Level: g.level, CameraX: g.camera.Position.X, Score: levelUpBonus})
 	}
 
 	// スコアとエネルギーの更新
-	g.score += dt * 10.0 // 1秒あたり10ポイント
-	g.energy -= dt * 5.0 // 1秒あたり5減少
+	g.addScore(g.boostedScore(dt * 10.0)) // 1秒あたり10ポイント
+	g.energy -= dt * EnergyDrainRate
 
 	if g.energy <= 0 {
 		g.energy = 0
-		g.SetGameOver()
+		g.SetGameOver(GameOverEnergyDrain)
 		return
 	}
 
+	// デバッグ表示の切り替え（展開に影響しないのでリプレイには記録しない）
+	if g.input.Btnp(ButtonDown) {
+		g.debugVisible = !g.debugVisible
+	}
+
 	// ボタン入力処理
-	if tic80.Btnp(tic80.BUTTON_A, 60000, 60000) && len(g.lines) > 0 {
+	if buttons.Pressed(ButtonA) && len(g.lines) > 0 {
 		g.lines[0].ToggleLane()
 	}
-	if tic80.Btnp(tic80.BUTTON_B, 60000, 60000) && len(g.lines) > 1 {
+	if buttons.Pressed(ButtonB) && len(g.lines) > 1 {
 		g.lines[1].ToggleLane()
 	}
 
 	// ツルハシ受け渡しボタン
-	if tic80.Btnp(tic80.BUTTON_X, 60000, 60000) {
+	if buttons.Pressed(ButtonX) {
 		oldOwner := g.pickaxeOwner
 		g.pickaxeOwner = 1 - g.pickaxeOwner // 0→1, 1→0 に切り替え
 
-		// 受け渡しエフェクト発生
 		// 両プレイヤーの位置を取得
 		if len(g.lines) >= 2 {
 			p1 := g.lines[oldOwner].player.position
@@ -170,13 +372,12 @@ func (g *Game) Update(dt float32) {
 
 			// ツルハシの位置（プレイヤー右側）に合わせる
 			offset := Vector2d{20, 8}
-			p1 = p1.Add(offset)
-			p2 = p2.Add(offset)
-
-			g.AddEffect(NewTransferEffect(p1, p2, g.speed))
-
-			// SFX: Pickaxe Transfer (14) Note: 57
-			tic80.Sfx(tic80.NewSoundEffectOptions().SetId(14).SetNote(57))
+			g.eventBus.Publish(PickaxeTransferred{
+				From:    oldOwner,
+				To:      g.pickaxeOwner,
+				FromPos: p1.Add(offset),
+				ToPos:   p2.Add(offset),
+			})
 		}
 	}
 
@@ -212,6 +413,7 @@ func (g *Game) Update(dt float32) {
 
 		// カメラをリセット
 		g.camera.Position.X -= resetOffset
+		g.prevCameraX -= resetOffset
 
 		// Spawnerに通知
 		g.spawner.OnCoordinateReset(resetOffset)
@@ -220,6 +422,8 @@ func (g *Game) Update(dt float32) {
 		for i := range g.lines {
 			if g.lines[i].player != nil {
 				g.lines[i].player.position.X -= resetOffset
+				g.lines[i].player.prevPos.X -= resetOffset
+				g.lines[i].player.lastHolePos.X -= resetOffset
 			}
 
 			// 全アイテムの座標をリセット
@@ -234,6 +438,12 @@ func (g *Game) Update(dt float32) {
 		g.effects.OnCoordinateReset(resetOffset)
 		g.bgEffects.OnCoordinateReset(resetOffset)
 	}
+
+	// ポーズ（このフレームの処理を終えてから止める。リプレイの再現性を保つため）
+	// このフレームでゲームオーバーになったときはGameOverSceneに任せる
+	if buttons.Pressed(ButtonY) && g.sceneManager != nil && !g.gameOver {
+		g.sceneManager.PushScene(NewPauseScene(g.sceneManager, g), true)
+	}
 }
 
 // SetSceneManager sets the scene manager for the game
@@ -241,6 +451,18 @@ func (g *Game) SetSceneManager(sm *SceneManager) {
 	g.sceneManager = sm
 }
 
+// GetMode は遊んでいるモードを返す
+func (g *Game) GetMode() GameMode {
+	return g.mode
+}
+
+// newTitleScene はこのゲームのモードを選んだ状態のタイトル画面を作成する
+func (g *Game) newTitleScene() *TitleScene {
+	title := NewTitleScene(g.sceneManager, g.modes)
+	title.SelectMode(g.mode.Name)
+	return title
+}
+
 // HasPickaxe は指定したラインのプレイヤーがツルハシを所持しているかを返す
 func (g *Game) HasPickaxe(lineIndex int) bool {
 	return g.pickaxeOwner == lineIndex
@@ -254,22 +476,26 @@ func (g *Game) AddEnergy(amount float32) {
 	}
 	if g.energy <= 0 {
 		g.energy = 0
-		g.SetGameOver()
+		g.SetGameOver(GameOverDamage)
 	}
 }
 
-// アイテムスポーン管理（spawnerに委譲）
+// アイテムスポーン管理（生成はspawnerに委譲し、結果をラインに置く）
 func (g *Game) spawnItems() {
 	if g.spawner.ShouldSpawn(g) {
-		g.spawner.SpawnItem(g)
+		g.placeSpawns(g.spawner.PlanSpawn(g.GetSpawnContext()))
 	}
 }
 
 func (g *Game) Draw() {
-	tic80.Cls(13)
+	g.renderer.Cls(13)
+
+	// 固定ステップ間はカメラを前回の更新からの移動量で外挿する
+	camera := g.camera
+	camera.Position.X += (g.camera.Position.X - g.prevCameraX) * g.drawAlpha
 
 	// 背景マップ描画
-	startWorldX := g.camera.Position.X - 120
+	startWorldX := camera.Position.X - 120
 	// Roundを使って整数座標に丸める（スプライトと合わせるため）
 	startWorldX_Int := Round(startWorldX)
 
@@ -290,29 +516,29 @@ func (g *Game) Draw() {
 
 	if mapX+tilesToDraw <= 240 {
 		// 通常描画（ラップなし）
-		tic80.Map(tic80.NewMapOptions().SetOffset(mapX, 0).SetSize(tilesToDraw, 18).SetPosition(-offsetX, 0))
+		g.renderer.Map(mapX, 0, tilesToDraw, 18, -offsetX, 0)
 	} else {
 		// ラップアラウンド描画（右端まで描画し、残りを左端から描画）
 		firstChunkWidth := 240 - mapX
 		secondChunkWidth := tilesToDraw - firstChunkWidth
 
 		// 1. 右端部分
-		tic80.Map(tic80.NewMapOptions().SetOffset(mapX, 0).SetSize(firstChunkWidth, 18).SetPosition(-offsetX, 0))
+		g.renderer.Map(mapX, 0, firstChunkWidth, 18, -offsetX, 0)
 
 		// 2. 左端部分（折り返し）
 		// 描画位置は -offsetX + (firstChunkWidth * 8)
-		tic80.Map(tic80.NewMapOptions().SetOffset(0, 0).SetSize(secondChunkWidth, 18).SetPosition(-offsetX+(firstChunkWidth*8), 0))
+		g.renderer.Map(0, 0, secondChunkWidth, 18, -offsetX+(firstChunkWidth*8), 0)
 	}
 
 	// 背景エフェクト描画
-	g.bgEffects.Draw(&g.camera)
+	g.bgEffects.Draw(g.renderer, &camera)
 
 	for i := range g.lines {
-		g.lines[i].Draw(&g.camera)
+		g.lines[i].Draw(g.renderer, &camera)
 	}
 
 	// エフェクト描画
-	g.effects.Draw(&g.camera)
+	g.effects.Draw(g.renderer, &camera)
 
 	// UI描画
 	g.DrawUI()
@@ -0,0 +1,98 @@
+package game
+
+// GameOverScene はゲームオーバー時にGameの上に重ねる演出シーン
+// 下のGameは止まったまま描画される
+type GameOverScene struct {
+	BaseScene
+
+	sceneManager     *SceneManager
+	game             *Game
+	timer            float32 // ゲームオーバー後の経過時間
+	canReturnToTitle bool    // タイトルに戻れるかどうか
+}
+
+func NewGameOverScene(sm *SceneManager, game *Game) *GameOverScene {
+	return &GameOverScene{
+		sceneManager: sm,
+		game:         game,
+	}
+}
+
+func (s *GameOverScene) Update(dt float32) {
+	s.timer += dt
+
+	// スコアを移動させる間はGameのスコア表示を隠す
+	if s.timer > 1.0 {
+		s.game.scoreHidden = true
+	}
+
+	// 約1.5秒後にタイトルに戻れるようにする
+	if s.timer > 1.5 {
+		s.canReturnToTitle = true
+	}
+
+	// ボタン入力でタイトルへ
+	if s.canReturnToTitle {
+		input := s.sceneManager.Platform().Input
+		if input.Btnp(ButtonA) || input.Btnp(ButtonB) {
+			s.sceneManager.TransitionTo(s.game.newTitleScene(), GameOverTransition)
+		}
+	}
+}
+
+func (s *GameOverScene) Draw() {
+	r := s.sceneManager.Platform().Renderer
+	g := s.game
+
+	// 1. GAME OVER テキスト (0.3秒後に表示)
+	if s.timer > 0.3 {
+		text := "GAME OVER"
+		DrawOutlinedText(r, text, 96, 50, 6, 12)
+	}
+
+	if s.timer <= 1.0 {
+		return
+	}
+
+	// 2. スコア位置のアニメーション
+	scoreText := "SC:" + intToString(int(g.score))
+	targetX := float32(240/2 - 20)
+	targetY := float32(66)
+
+	currentX := float32(2)
+	currentY := float32(uiBaseY)
+
+	t := (s.timer - 1.0) / 0.5
+	t = float32(Clamp(float64(t), 0.0, 1.0))
+	easeT := EaseInOutCubic(t)
+
+	drawX := Lerp(currentX, targetX, easeT)
+	drawY := Lerp(currentY, targetY, easeT)
+
+	DrawOutlinedText(r, scoreText, int(drawX), int(drawY), 4, 14)
+
+	if s.canReturnToTitle {
+		color := 12
+		if (int(s.timer*2) % 2) == 0 {
+			color = 0
+		}
+		prompt := "PRESS BUTTON"
+		promptWidth := r.Print(prompt, 0, -10, PrintOptions{Color: 15})
+		r.Print(prompt, (240-promptWidth)/2, 80, PrintOptions{Color: color})
+	}
+
+	// 3. ハイスコア表示（ランクインしたら点滅で強調）
+	if g.highScoreRank >= 0 {
+		color := 4
+		if (int(s.timer*4) % 2) == 0 {
+			color = 12
+		}
+		text := "NEW RECORD! No." + intToString(g.highScoreRank+1)
+		textWidth := r.Print(text, 0, -10, PrintOptions{Color: 15})
+		DrawOutlinedText(r, text, (240-textWidth)/2, 92, color, 0)
+	} else if g.replayer == nil {
+		text := "BEST:" + intToString(g.bestScore)
+		textWidth := r.Print(text, 0, -10, PrintOptions{Color: 15})
+		r.Print(text, (240-textWidth)/2, 92, PrintOptions{Color: 13})
+	}
+}
@@ -0,0 +1,121 @@
+package generators
+
+import (
+	"strconv"
+
+	"GolangGame251130/internal/game"
+)
+
+// AdaptiveMaxSkill bounds Adaptive.Skill in both directions.
+const AdaptiveMaxSkill = 3
+
+// adaptiveWindow is the number of random chunk grids a player has to do well
+// in before the difficulty goes up.
+const adaptiveWindow = 30
+
+// Change of the chunk parameters per skill step (see Adaptive.Adjust).
+const (
+	adaptiveRockStep     = 5
+	adaptiveObstacleStep = 5
+	adaptiveFoodStep     = 3
+)
+
+// Adaptive adjusts the chunk parameters to how the player is doing, on top of
+// the scaling by level. It counts the hits taken and the food eaten in a
+// window of random chunk grids and moves its skill estimate by one step:
+//
+//   - down as soon as the gophers took two hits, or lost energy while below
+//     half of it; the window starts over so a bad streak keeps easing off
+//   - up when a whole window of adaptiveWindow grids passed without hits or
+//     loss of energy, with at least 70 energy left
+//
+// Struggling players are helped quickly while experts have to prove
+// themselves for a while. A positive skill raises RockSpawnRate and
+// ObstacleDensity and lowers FoodSpawnRate; a negative one does the opposite.
+// The results stay within the ranges documented on ChunkParams.
+type Adaptive struct {
+	Skill int // -AdaptiveMaxSkill (struggling) to AdaptiveMaxSkill (expert)
+
+	grids       int     // Grids generated in the current window
+	hits, food  int     // Outcomes since the current window started
+	energyStart float32 // Energy when the current window started (-1 before the first grid)
+
+	// The last finished window, for the debug readout
+	lastHits, lastFood int
+	lastEnergy         float32
+}
+
+func NewAdaptive() *Adaptive {
+	return &Adaptive{energyStart: -1}
+}
+
+// OnItemResolved counts the hits taken and the food eaten.
+func (a *Adaptive) OnItemResolved(item game.Item, outcome game.ItemOutcome) {
+	switch {
+	case outcome == game.ItemHit:
+		a.hits++
+	case outcome == game.ItemEaten && item.Kind() == game.ItemFood:
+		a.food++
+	}
+}
+
+// OnGrid is called for every grid of a random chunk with the current energy.
+// When the window ends it updates the skill and starts counting the next one.
+func (a *Adaptive) OnGrid(energy float32) {
+	if a.energyStart < 0 {
+		a.energyStart = energy
+	}
+	a.grids++
+	trend := energy - a.energyStart
+	struggling := a.hits >= 2 || (trend < 0 && energy < 50)
+	if !struggling && a.grids < adaptiveWindow {
+		return
+	}
+
+	thriving := a.hits == 0 && trend >= 0 && energy >= 70
+	if struggling && a.Skill > -AdaptiveMaxSkill {
+		a.Skill--
+	} else if thriving && a.Skill < AdaptiveMaxSkill {
+		a.Skill++
+	}
+	a.lastHits, a.lastFood, a.lastEnergy = a.hits, a.food, trend
+
+	a.grids, a.hits, a.food = 0, 0, 0
+	a.energyStart = energy
+}
+
+// Adjust returns the chunk parameters nudged by the skill estimate.
+func (a *Adaptive) Adjust(p ChunkParams) ChunkParams {
+	p.RockSpawnRate = clampInt(p.RockSpawnRate+a.Skill*adaptiveRockStep, 10, 60)
+	p.ObstacleDensity = clampInt(p.ObstacleDensity+a.Skill*adaptiveObstacleStep, 20, 80)
+	p.FoodSpawnRate = clampInt(p.FoodSpawnRate-a.Skill*adaptiveFoodStep, 0, 20)
+	return p
+}
+
+// DebugText summarizes the skill estimate, the adjustment and the last window's outcomes.
+func (a *Adaptive) DebugText() string {
+	return "DDA " + signed(a.Skill) +
+		" R" + signed(a.Skill*adaptiveRockStep) +
+		" O" + signed(a.Skill*adaptiveObstacleStep) +
+		" F" + signed(-a.Skill*adaptiveFoodStep) +
+		" | HIT " + strconv.Itoa(a.lastHits) +
+		" FOOD " + strconv.Itoa(a.lastFood) +
+		" E" + signed(int(a.lastEnergy))
+}
+
+func signed(v int) string {
+	if v >= 0 {
+		return "+" + strconv.Itoa(v)
+	}
+	return strconv.Itoa(v)
+}
+
+func clampInt(v, lo, hi int) int {
+	if v < lo {
+		return lo
+	}
+	if v > hi {
+		return hi
+	}
+	return v
+}
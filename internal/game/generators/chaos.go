@@ -0,0 +1,26 @@
+package generators
+
+import (
+	"GolangGame251130/internal/game"
+)
+
+// NewChaosGenerator returns a PathGenerator for the Chaos mode: short chunks
+// whose parameters are drawn from the full range regardless of the level, so
+// a calm stretch can be followed by a wall of obstacles at any time.
+func NewChaosGenerator(rng *game.RNG) *PathGenerator {
+	gen := NewPathGenerator(rng)
+	gen.chaos = true
+	return gen
+}
+
+// chaosChunk draws chunk parameters uniformly from the widest ranges PathGenerator uses.
+func chaosChunk(rng *game.RNG) ChunkParams {
+	return ChunkParams{
+		LaneSwitchChance: rng.Intn(31),
+		LineSwitchChance: rng.Intn(21),
+		RockSpawnRate:    10 + rng.Intn(51),
+		FoodSpawnRate:    rng.Intn(21),
+		ObstacleDensity:  20 + rng.Intn(61),
+		HazardRate:       rng.Intn(21),
+	}
+}
@@ -0,0 +1,132 @@
+package generators
+
+import (
+	"math"
+
+	"GolangGame251130/internal/game"
+)
+
+//go:generate go run -mod=vendor ../../../cmd/chunkgen -dir ../../../chunks -out templates_gen.go
+
+// Empty marks a template cell without an item.
+const Empty game.ItemKind = -1
+
+// TemplateChance is the chance (%) that a new chunk is a template instead of random parameters.
+const TemplateChance = 25
+
+// templateClearGrids is the number of empty grids before and after a template,
+// so the gophers can reach any lane and pass the pickaxe on the way in and out.
+// It also covers the grids kept clear after a mole spawned just before.
+const templateClearGrids = moleClearGrids
+
+// ChunkTemplate is a designer-authored set-piece spliced in between random chunks.
+type ChunkTemplate struct {
+	Name     string
+	MinLevel int // Lowest level the template appears at
+	MaxLevel int // Highest level the template appears at (0 = no limit)
+	Weight   int // Relative chance among the templates allowed at a level
+
+	// Grid holds one row per line and lane, one item kind per 24px grid column.
+	// Empty leaves a cell clear. Shorter rows are padded with Empty.
+	Grid [2][2][]game.ItemKind // [line][lane][column]
+}
+
+// Columns returns the number of grid columns of the template.
+func (t *ChunkTemplate) Columns() int {
+	n := 0
+	for _, line := range t.Grid {
+		for _, row := range line {
+			n = max(n, len(row))
+		}
+	}
+	return n
+}
+
+// Cell returns the item kind at a grid cell (Empty outside the grid).
+func (t *ChunkTemplate) Cell(line, lane, column int) game.ItemKind {
+	row := t.Grid[line][lane]
+	if column < 0 || column >= len(row) {
+		return Empty
+	}
+	return row[column]
+}
+
+// Allows reports whether the template may appear at the level.
+func (t *ChunkTemplate) Allows(level int) bool {
+	if t.Weight <= 0 || level < t.MinLevel {
+		return false
+	}
+	return t.MaxLevel == 0 || level <= t.MaxLevel
+}
+
+// Hazards returns the items of the template as the checker sees them, with
+// the first column at x.
+func (t *ChunkTemplate) Hazards(x float32) []Hazard {
+	hs := []Hazard{}
+	for column := 0; column < t.Columns(); column++ {
+		for line := 0; line < 2; line++ {
+			for lane := 0; lane < 2; lane++ {
+				kind := t.Cell(line, lane, column)
+				if kind == Empty {
+					continue
+				}
+				hx := x + float32(column*gridSize)
+				hs = append(hs, Hazard{Line: line, Lane: lane, X: hx, Kind: kind, SpawnPlayerX: hx - spawnLead})
+			}
+		}
+	}
+	return hs
+}
+
+// CheckTemplate checks that the template can be played through at the
+// level's speed. It is entered after clear grids, so it has to be solvable
+// from any lane and pickaxe owner. The template's first column is at X 0
+// (see TemplateColumn).
+func CheckTemplate(t *ChunkTemplate, level int) CheckResult {
+	checker := Checker{Speed: game.LevelSpeed(level)}
+	for _, h := range t.Hazards(0) {
+		checker.Add(h)
+	}
+	fromX := float32(-templateClearGrids * gridSize)
+	endX := float32((t.Columns() + templateClearGrids) * gridSize)
+	for lane0 := 0; lane0 < 2; lane0++ {
+		for lane1 := 0; lane1 < 2; lane1++ {
+			for owner := 0; owner < 2; owner++ {
+				res := checker.Check(fromX, endX, SettledState(lane0, lane1, owner))
+				if !res.Solvable {
+					return res
+				}
+			}
+		}
+	}
+	return CheckResult{Solvable: true, Settled: true}
+}
+
+// TemplateColumn converts an X of a CheckTemplate result to a template column (0-based).
+func TemplateColumn(x float32) int {
+	return int(math.Floor(float64(x) / gridSize))
+}
+
+// pickTemplate chooses a template allowed at the level by weight (nil if none).
+func pickTemplate(rng *game.RNG, templates []ChunkTemplate, level int) *ChunkTemplate {
+	total := 0
+	for i := range templates {
+		if templates[i].Allows(level) {
+			total += templates[i].Weight
+		}
+	}
+	if total == 0 {
+		return nil
+	}
+	r := rng.Intn(total)
+	for i := range templates {
+		if !templates[i].Allows(level) {
+			continue
+		}
+		r -= templates[i].Weight
+		if r < 0 {
+			return &templates[i]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,39 @@
+package generators
+
+import (
+	"testing"
+
+	"GolangGame251130/internal/game"
+)
+
+// templateCheckLevels is the highest level templates are checked at, as in cmd/chunkgen.
+const templateCheckLevels = 10
+
+// TestTemplatesSolvable runs CheckTemplate over DefaultChunkTemplates at each
+// level they appear at, and over TutorialLessons at level 1 where the
+// tutorial plays them.
+func TestTemplatesSolvable(t *testing.T) {
+	for i := range DefaultChunkTemplates {
+		tmpl := &DefaultChunkTemplates[i]
+		for level := 1; level <= templateCheckLevels; level++ {
+			if tmpl.Allows(level) {
+				checkTemplateSolvable(t, tmpl, level)
+			}
+		}
+	}
+	for i := range TutorialLessons {
+		checkTemplateSolvable(t, &TutorialLessons[i], 1)
+	}
+}
+
+func checkTemplateSolvable(t *testing.T, tmpl *ChunkTemplate, level int) {
+	t.Helper()
+	res := CheckTemplate(tmpl, level)
+	if res.Solvable {
+		return
+	}
+	t.Errorf("%q level %d: unsolvable", tmpl.Name, level)
+	for _, h := range res.Blocking {
+		t.Logf("    line %d lane %d column %d %s", h.Line, h.Lane, TemplateColumn(h.X)+1, game.ItemDefs[h.Kind].Name)
+	}
+}
@@ -0,0 +1,78 @@
+package generators
+
+import (
+	"GolangGame251130/internal/game"
+)
+
+// EnergyBudget makes sure the food a gopher can reach keeps up with the energy
+// that drains over time (game.EnergyDrainRate), so a run never ends because
+// the random chunks happened to spawn no food.
+//
+// Balance is the energy of the reachable food generated so far minus the
+// energy drained while the gophers cross the same columns at the current
+// speed. A surplus counts for at most one food, so a cache of food early on
+// does not allow a long stretch without any later. While the balance is below
+// zero the generator puts food on every free path grid of random chunks (the
+// path of the line with the pickaxe when no rock was rolled for it). Only
+// safety grids, grids around moles and templates are left as they are, so the
+// reachable food never falls more than MaxFoodOwed food behind the drain
+// (see StreamChecker).
+//
+// Food is reachable when it is in the path lane of its line; template columns
+// count one food per line, whatever the lane. Hits are not budgeted, they are
+// up to the player.
+type EnergyBudget struct {
+	Balance float32
+}
+
+func NewEnergyBudget() *EnergyBudget {
+	return &EnergyBudget{}
+}
+
+// FoodEnergy is the energy of one food.
+func FoodEnergy() float32 {
+	return game.ItemDefs[game.ItemFood].EnergyDelta
+}
+
+// GridDrain returns the energy drained while the gophers cross one grid
+// column at speed. Speeds below the level 1 speed count as the level 1 speed.
+func GridDrain(speed float32) float32 {
+	speed = max(speed, game.LevelSpeed(1))
+	return game.EnergyDrainRate * gridSize / speed
+}
+
+// NeedsFood tells whether the generator has to place food.
+func (b *EnergyBudget) NeedsFood() bool {
+	return b.Balance < 0
+}
+
+// OnGrid adds the reachable food of a column and subtracts its drain.
+func (b *EnergyBudget) OnGrid(speed float32, food int) {
+	b.Balance = min(b.Balance+float32(food)*FoodEnergy()-GridDrain(speed), FoodEnergy())
+}
+
+// DebugText shows the balance.
+func (b *EnergyBudget) DebugText() string {
+	return "BAL" + signed(int(b.Balance))
+}
+
+// ReachableFood counts the lines of a column that have reachable food
+// (see EnergyBudget).
+func ReachableFood(spawns []game.Spawn, info ColumnInfo) int {
+	var found [2]bool
+	for _, s := range spawns {
+		if s.Kind != game.ItemFood {
+			continue
+		}
+		if info.Template != "" || s.Lane == info.PathLanes[s.Line] {
+			found[s.Line] = true
+		}
+	}
+	n := 0
+	for _, f := range found {
+		if f {
+			n++
+		}
+	}
+	return n
+}
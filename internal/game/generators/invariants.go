@@ -0,0 +1,144 @@
+package generators
+
+import (
+	"fmt"
+
+	"GolangGame251130/internal/game"
+)
+
+// MaxFoodOwed is how many food the reachable food may fall behind the energy
+// drain (see EnergyBudget).
+const MaxFoodOwed = 2
+
+// ParamRange is the range of one chunk parameter, as documented on ChunkParams.
+type ParamRange struct {
+	Name     string
+	Get      func(p ChunkParams) int
+	Min, Max int // Over all levels
+	Level1   int // Highest value the level scaling gives at level 1 and below
+}
+
+// ParamRanges lists the ranges the generator produces: the scaling by level
+// in PlanSpawn, the adaptive difficulty and the Chaos mode stay within Min-Max.
+var ParamRanges = []ParamRange{
+	{"LaneSwitchChance", func(p ChunkParams) int { return p.LaneSwitchChance }, 0, 30, 10},
+	{"LineSwitchChance", func(p ChunkParams) int { return p.LineSwitchChance }, 0, 20, 5},
+	{"RockSpawnRate", func(p ChunkParams) int { return p.RockSpawnRate }, 10, 60, 20},
+	{"FoodSpawnRate", func(p ChunkParams) int { return p.FoodSpawnRate }, 0, 20, 20},
+	{"ObstacleDensity", func(p ChunkParams) int { return p.ObstacleDensity }, 20, 80, 40},
+	{"HazardRate", func(p ChunkParams) int { return p.HazardRate }, 0, 20, 0},
+}
+
+// StreamChecker checks the invariants of the columns a PathGenerator plans,
+// one column at a time:
+//
+//   - a line never has both lanes blocked by unbreakable obstacles
+//     (HardRock or Stalactite) in the same grid
+//   - a line's safety grids after a lane switch are empty
+//   - columns are numbered in order and their X keeps increasing, once the
+//     coordinate resets are taken into account
+//   - chunk parameters stay in the ParamRanges, and levels below 1 use the
+//     level 1 ranges (except in the Chaos mode, whose parameters ignore the
+//     level, and while the adaptive difficulty has moved them)
+//   - with the food guarantee, the reachable food never falls more than
+//     MaxFoodOwed food behind the energy drain
+type StreamChecker struct {
+	gen        *PathGenerator
+	prevColumn int
+	prevX      float32
+	shift      float32 // Offset of the coordinate resets since the previous column
+	balance    float32 // Reachable food energy minus drain, kept like EnergyBudget
+}
+
+func NewStreamChecker(gen *PathGenerator) *StreamChecker {
+	return &StreamChecker{gen: gen, prevColumn: -1}
+}
+
+// OnCoordinateReset is called along with the generator's OnCoordinateReset.
+func (c *StreamChecker) OnCoordinateReset(offset float32) {
+	c.shift += offset
+}
+
+// Check checks the column the generator just planned with ctx.
+func (c *StreamChecker) Check(ctx game.SpawnContext, plan game.SpawnPlan) error {
+	if err := c.check(ctx, plan); err != nil {
+		return fmt.Errorf("column %d: %v", plan.Column, err)
+	}
+	return nil
+}
+
+func (c *StreamChecker) check(ctx game.SpawnContext, plan game.SpawnPlan) error {
+	info := c.gen.GetColumnInfo()
+
+	if plan.Column != c.prevColumn+1 {
+		return fmt.Errorf("follows column %d", c.prevColumn)
+	}
+	if c.prevColumn >= 0 && plan.X+c.shift <= c.prevX {
+		return fmt.Errorf("X %g (%g before resets) does not follow %g", plan.X, plan.X+c.shift, c.prevX)
+	}
+	if c.prevColumn >= 0 && plan.X+c.shift-c.prevX > gridSize+1 {
+		return fmt.Errorf("X %g skips ahead of %g", plan.X+c.shift, c.prevX)
+	}
+	c.prevColumn, c.prevX, c.shift = plan.Column, plan.X, 0
+
+	if c.gen.budget != nil {
+		food := float32(ReachableFood(plan.Spawns, info)) * FoodEnergy()
+		c.balance = min(c.balance+food-GridDrain(ctx.Speed), FoodEnergy())
+		if c.balance < -MaxFoodOwed*FoodEnergy() {
+			return fmt.Errorf("reachable food %g energy behind the drain", -c.balance)
+		}
+	}
+
+	if err := checkSpawns(plan, info); err != nil {
+		return err
+	}
+	if info.Template != "" {
+		return nil
+	}
+	scaled := !c.gen.chaos && (c.gen.adaptive == nil || c.gen.adaptive.Skill == 0)
+	for _, pr := range ParamRanges {
+		v := pr.Get(info.Params)
+		hi := pr.Max
+		if scaled && c.gen.level <= 1 {
+			hi = pr.Level1
+		}
+		if v < pr.Min || v > hi {
+			return fmt.Errorf("%s = %d outside %d-%d at level %d", pr.Name, v, pr.Min, hi, c.gen.level)
+		}
+	}
+	return nil
+}
+
+// checkSpawns checks the items of one planned column.
+func checkSpawns(plan game.SpawnPlan, info ColumnInfo) error {
+	var unbreakable, occupied [2][2]bool
+	for _, s := range plan.Spawns {
+		if s.Column != plan.Column {
+			return fmt.Errorf("spawn of column %d in the plan", s.Column)
+		}
+		if s.Line < 0 || s.Line > 1 || s.Lane < 0 || s.Lane > 1 {
+			return fmt.Errorf("spawn at line %d lane %d", s.Line, s.Lane)
+		}
+		if s.XOffset < 0 || s.XOffset >= gridSize {
+			return fmt.Errorf("X offset %g outside the grid", s.XOffset)
+		}
+		if occupied[s.Line][s.Lane] {
+			return fmt.Errorf("two items at line %d lane %d", s.Line, s.Lane)
+		}
+		occupied[s.Line][s.Lane] = true
+		def := &game.ItemDefs[s.Kind]
+		if def.Obstacle && !def.Breakable && s.Kind != game.ItemMole {
+			unbreakable[s.Line][s.Lane] = true
+		}
+	}
+
+	for line := 0; line < 2; line++ {
+		if unbreakable[line][0] && unbreakable[line][1] {
+			return fmt.Errorf("both lanes of line %d blocked by unbreakable obstacles", line)
+		}
+		if info.Template == "" && info.Safety[line] && (occupied[line][0] || occupied[line][1]) {
+			return fmt.Errorf("safety grid of line %d is not empty", line)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,96 @@
+package generators
+
+import (
+	"testing"
+
+	"GolangGame251130/internal/game"
+)
+
+// fuzzGrids is the number of columns generated per fuzz input.
+const fuzzGrids = 200
+
+// FuzzPathGenerator runs the registered generators with the seed and level
+// and checks every column with a StreamChecker. Each byte of ops drives one
+// column: the gophers' lanes, the pickaxe owner, the energy and, now and then,
+// a coordinate reset.
+func FuzzPathGenerator(f *testing.F) {
+	f.Add(uint32(1), 1, uint8(0), []byte{0x00})
+	f.Add(uint32(2), 0, uint8(0), []byte{0x13, 0x80, 0x40})
+	f.Add(uint32(3), -5, uint8(1), []byte{0x07, 0xf0})
+	f.Add(uint32(4), 5, uint8(1), []byte{0x00, 0x3c, 0xa5, 0x5a})
+	f.Add(uint32(5), 10, uint8(2), []byte{0xff, 0x10})
+	f.Add(uint32(6), 25, uint8(0), []byte{0x21, 0x42, 0x84, 0x08})
+	f.Add(uint32(1000), 3, uint8(2), []byte{0x90})
+
+	f.Fuzz(func(t *testing.T, seed uint32, level int, genIndex uint8, ops []byte) {
+		names := DefaultRegistry.Names()
+		name := names[int(genIndex)%len(names)]
+		factory, _ := DefaultRegistry.Lookup(name)
+		gen, ok := factory(game.NewRNG(seed)).(*PathGenerator)
+		if !ok {
+			t.Fatalf("%s: not a PathGenerator", name)
+		}
+		gen.OnLevelUp(level)
+		checker := NewStreamChecker(gen)
+
+		// The speed is never below the level 1 speed in a running game
+		speed := game.LevelSpeed(max(min(level, 1000), 1))
+		for n := 0; n < fuzzGrids; n++ {
+			op := byte(n)
+			if len(ops) > 0 {
+				op = ops[n%len(ops)] ^ byte(n/len(ops))
+			}
+			if op&0x0f == 0 {
+				offset := float32(op) * 3.7
+				gen.OnCoordinateReset(offset)
+				checker.OnCoordinateReset(offset)
+			}
+			ctx := game.SpawnContext{
+				Speed:        speed,
+				Lanes:        [2]int{int(op>>4) & 1, int(op>>5) & 1},
+				PickaxeOwner: int(op>>6) & 1,
+				Energy:       float32(op) / 255 * 100,
+			}
+			if err := checker.Check(ctx, gen.PlanSpawn(ctx)); err != nil {
+				t.Fatalf("%s seed %d level %d: %v", name, seed, level, err)
+			}
+		}
+	})
+}
+
+// FuzzGetScaledValue checks that the value stays between the ranges it is
+// scaled between, and that levels outside 1-10 use the nearest end.
+func FuzzGetScaledValue(f *testing.F) {
+	f.Add(uint32(1), 1, 0, 10, 5, 30)
+	f.Add(uint32(2), 10, 10, 20, 30, 60)
+	f.Add(uint32(3), 0, 0, 20, 0, 10)
+	f.Add(uint32(4), -7, 20, 40, 30, 80)
+	f.Add(uint32(5), 42, 0, 0, 5, 20)
+	f.Add(uint32(6), 5, 60, 10, 80, 30)
+
+	f.Fuzz(func(t *testing.T, seed uint32, level, minV, maxV, minTarget, maxTarget int) {
+		for _, v := range []int{minV, maxV, minTarget, maxTarget} {
+			if v < -10000 || v > 10000 {
+				t.Skip("parameters are percentages")
+			}
+		}
+
+		got := getScaledValue(game.NewRNG(seed), level, minV, maxV, minTarget, maxTarget)
+		lo := min(minV, maxV, minTarget, maxTarget)
+		hi := max(minV, maxV, minTarget, maxTarget)
+		if got < lo || got > hi {
+			t.Fatalf("level %d: %d outside %d-%d", level, got, lo, hi)
+		}
+
+		clamped := min(max(level, 1), 10)
+		if want := getScaledValue(game.NewRNG(seed), clamped, minV, maxV, minTarget, maxTarget); got != want {
+			t.Fatalf("level %d: %d, level %d: %d", level, got, clamped, want)
+		}
+		if clamped == 1 && (got < min(minV, maxV) || got > max(minV, maxV)) {
+			t.Fatalf("level %d: %d outside the level 1 range %d-%d", level, got, minV, maxV)
+		}
+		if clamped == 10 && (got < min(minTarget, maxTarget) || got > max(minTarget, maxTarget)) {
+			t.Fatalf("level %d: %d outside the level 10 range %d-%d", level, got, minTarget, maxTarget)
+		}
+	})
+}
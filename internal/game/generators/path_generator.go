@@ -5,72 +5,254 @@ import (
 )
 
 // ChunkParams holds the parameters for the current level chunk.
+// The ranges are over all levels (see getScaledValue in PlanSpawn) and the Chaos mode.
 type ChunkParams struct {
-	LaneSwitchChance int // 0-20%
-	LineSwitchChance int // 0-10% (Probability to switch target pickaxe owner)
-	RockSpawnRate    int // 30-80% (Probability of rock on target path)
-	FoodSpawnRate    int // 0-30% (Probability of food on non-target path)
-	ObstacleDensity  int // 20-60% (Probability of obstacle off-path)
+	LaneSwitchChance int // 0-30%
+	LineSwitchChance int // 0-20% (Probability to switch target pickaxe owner)
+	RockSpawnRate    int // 10-60% (Probability of rock on target path)
+	FoodSpawnRate    int // 0-20% (Probability of food on non-target path)
+	ObstacleDensity  int // 20-80% (Probability of obstacle off-path)
+	HazardRate       int // 0-20% (Probability of a moving hazard instead of a static one)
 }
 
+// ColumnInfo describes how the last planned column was generated, for tools
+// that check the generator from outside.
+type ColumnInfo struct {
+	Template  string      // Name of the template being spawned ("" for a random chunk)
+	Params    ChunkParams // Parameters of the random chunk
+	PathLanes [2]int      // Safe lane of each line
+	Safety    [2]bool     // The line is kept clear after a lane switch
+}
+
+// gridRecord is what was generated for one line in one grid column.
+type gridRecord struct {
+	pathLane int
+	safety   bool
+	occupied [2]bool
+}
+
+const (
+	historyLength     = 8 // Grids remembered per line (enough for a boulder to roll back)
+	moleClearGrids    = 2 // Grids kept empty on both sides of a mole
+	boulderClearGrids = 8 // Grids behind a boulder whose lane must stay off-path
+)
+
+// PowerUpSpawnRate is the chance (%) of a power-up on an empty non-target path grid.
+const PowerUpSpawnRate = 2
+
+// gridSize is the width of one generated column.
+// spawnAhead is how far ahead of the camera items are spawned.
+// The camera leads the frontmost player by 60px, so items appear spawnLead ahead of the player.
+const (
+	gridSize   = 24
+	spawnAhead = 320
+	spawnLead  = spawnAhead + 60
+)
+
 // PathGenerator handles level generation with a specific path logic.
 // Grid size: 24px
 type PathGenerator struct {
-	nextSpawnX         float32
-	pathLanes          []int // Current safe lane for each line (0 or 1)
-	switchSafety       []int // Counter for safety duration after switch
-	targetPickaxeOwner int   // Which player *should* have the pickaxe (0 or 1)
+	game.BaseLevelGenerator
+
+	rng   *game.RNG // Level generation stream owned by the Game
+	level int       // Level of the game, kept up to date by OnLevelUp
+
+	column             int     // Grid column being generated
+	nextSpawnX         float32 // World X of the column
+	pathLanes          []int   // Current safe lane for each line (0 or 1)
+	switchSafety       []int   // Counter for safety duration after switch
+	targetPickaxeOwner int     // Which player *should* have the pickaxe (0 or 1)
+
+	// Recent grids per line (oldest first), used to place moving hazards safely
+	history        [][]gridRecord
+	clearRemaining []int // Grids left to keep empty after a mole
+
+	// Optional runtime solvability check (see SetPatchUnfair)
+	patchUnfair bool
+	fairness    [2]fairnessCheck // At the level's speed and during a speed burst
+	spawned     []game.Spawn     // Items of the column being generated
 
 	// Chunk management
-	chunkRemaining int         // Number of grids remaining in current chunk
-	currentChunk   ChunkParams // Current chunk parameters
+	chunkRemaining int             // Number of grids remaining in current chunk
+	currentChunk   ChunkParams     // Current chunk parameters
+	templates      []ChunkTemplate // Set-pieces to splice in between random chunks
+	template       *ChunkTemplate  // Template being spawned (nil for a random chunk)
+	templateColumn int             // Next template column (negative while clearing the way in)
+	lessons        []ChunkTemplate // Templates spawned in order before any random chunk
+	lesson         int             // Next lesson to spawn
+	info           ColumnInfo      // See GetColumnInfo
+	chaos          bool            // Chunk parameters ignore the level (see NewChaosGenerator)
+	adaptive       *Adaptive       // Adjusts chunk parameters to the player (nil when off)
+	budget         *EnergyBudget   // Guarantees food for the energy drain (nil when off)
 }
 
-func NewPathGenerator() *PathGenerator {
+func NewPathGenerator(rng *game.RNG) *PathGenerator {
 	gen := &PathGenerator{
-		nextSpawnX:         400,
-		pathLanes:          []int{0, 1}, // Initial lanes
-		switchSafety:       []int{0, 0},
-		targetPickaxeOwner: 0,
-		chunkRemaining:     0, // Will trigger new chunk immediately
+		rng:       rng,
+		templates: DefaultChunkTemplates,
 	}
+	gen.reset()
 	return gen
 }
 
+// reset puts the generator back to the start of a run, keeping its settings.
+func (g *PathGenerator) reset() {
+	g.level = 1
+	g.column = 0
+	g.nextSpawnX = 400
+	g.pathLanes = []int{0, 1} // Initial lanes
+	g.switchSafety = []int{0, 0}
+	g.targetPickaxeOwner = 0
+	g.history = [][]gridRecord{{}, {}}
+	g.clearRemaining = []int{0, 0}
+	for i := range g.fairness {
+		g.fairness[i] = fairnessCheck{frontierX: -1}
+	}
+	g.spawned = nil
+	g.chunkRemaining = 0 // Will trigger new chunk immediately
+	g.currentChunk = ChunkParams{}
+	g.template = nil
+	g.templateColumn = 0
+	g.lesson = 0
+	g.info = ColumnInfo{}
+	if g.adaptive != nil {
+		g.adaptive = NewAdaptive()
+	}
+	if g.budget != nil {
+		g.budget = NewEnergyBudget()
+	}
+}
+
+// Reset restarts the generator for a new run with the seed. The Game's
+// level generation stream is reseeded, so the run matches a fresh generator.
+func (g *PathGenerator) Reset(seed uint32) {
+	g.rng.Seed(seed)
+	g.reset()
+}
+
+// SetAdaptive enables the adjustment of chunk parameters to how the player
+// is doing (see Adaptive). It has no effect on the Chaos mode.
+func (g *PathGenerator) SetAdaptive(enabled bool) {
+	g.adaptive = nil
+	if enabled {
+		g.adaptive = NewAdaptive()
+	}
+}
+
+// GetAdaptive returns the adaptive layer (nil when off).
+func (g *PathGenerator) GetAdaptive() *Adaptive {
+	return g.adaptive
+}
+
+// SetEnergyBudget enables the food guarantee (see EnergyBudget).
+func (g *PathGenerator) SetEnergyBudget(enabled bool) {
+	g.budget = nil
+	if enabled {
+		g.budget = NewEnergyBudget()
+	}
+}
+
+// GetEnergyBudget returns the food guarantee (nil when off).
+func (g *PathGenerator) GetEnergyBudget() *EnergyBudget {
+	return g.budget
+}
+
+// needsFood tells whether the food guarantee wants food in this grid.
+func (g *PathGenerator) needsFood() bool {
+	return g.budget != nil && g.budget.NeedsFood()
+}
+
+// OnItemResolved feeds the adaptive layer.
+func (g *PathGenerator) OnItemResolved(line int, item game.Item, outcome game.ItemOutcome) {
+	if g.adaptive != nil {
+		g.adaptive.OnItemResolved(item, outcome)
+	}
+}
+
+// DebugText shows the state of the adaptive layer and the food guarantee (see game.DebugInfo).
+func (g *PathGenerator) DebugText() string {
+	text := ""
+	if g.adaptive != nil {
+		text = g.adaptive.DebugText()
+	}
+	if g.budget != nil {
+		if text != "" {
+			text += " | "
+		}
+		text += g.budget.DebugText()
+	}
+	return text
+}
+
+// OnLevelUp sets the level new chunks are generated for.
+func (g *PathGenerator) OnLevelUp(level int) {
+	g.level = level
+}
+
 func (g *PathGenerator) ShouldSpawn(gameInst *game.Game) bool {
 	// Spawn ahead of camera
-	spawnThreshold := gameInst.GetCameraX() + 320
+	spawnThreshold := gameInst.GetCameraX() + spawnAhead
 	return g.nextSpawnX < spawnThreshold
 }
 
-func (g *PathGenerator) SpawnItem(gameInst *game.Game) {
-	lines := gameInst.GetLines()
-	gridSize := float32(24.0)
+// GetColumnInfo returns how the last planned column was generated.
+func (g *PathGenerator) GetColumnInfo() ColumnInfo {
+	return g.info
+}
+
+func (g *PathGenerator) PlanSpawn(ctx game.SpawnContext) game.SpawnPlan {
+	g.info = ColumnInfo{}
 
 	// --- 0. Update Chunk State ---
 	if g.chunkRemaining <= 0 {
 		// Start new chunk
-		g.chunkRemaining = game.RandomIntn(16) + 15 // 15 to 30 grids
+		// A template never follows another one directly
+		wasTemplate := g.template != nil
+		g.template = nil
+		if g.lesson < len(g.lessons) {
+			g.template = &g.lessons[g.lesson]
+			g.lesson++
+		} else if !wasTemplate && g.rng.Intn(100) < TemplateChance {
+			g.template = pickTemplate(g.rng, g.templates, g.level)
+		}
+	}
+	if g.template != nil {
+		g.info.Template = g.template.Name
+		g.spawnTemplateColumn()
+		return g.finishGrid(ctx)
+	}
+	if g.chunkRemaining <= 0 && g.chaos {
+		g.chunkRemaining = g.rng.Intn(6) + 5 // 5 to 10 grids
+		g.currentChunk = chaosChunk(g.rng)
+	}
+	if g.chunkRemaining <= 0 {
+		g.chunkRemaining = g.rng.Intn(16) + 15 // 15 to 30 grids
 
-		level := gameInst.GetLevel()
+		level := g.level
 
 		// Randomize parameters scaling with level
 		// Level 1 -> 10 Scaling
 
-		g.currentChunk.LaneSwitchChance = getScaledValue(level, 0, 10, 5, 30)
-		g.currentChunk.LineSwitchChance = getScaledValue(level, 0, 5, 5, 20)
-		g.currentChunk.RockSpawnRate = getScaledValue(level, 10, 20, 30, 60)
-		g.currentChunk.FoodSpawnRate = getScaledValue(level, 0, 20, 0, 10)
-		g.currentChunk.ObstacleDensity = getScaledValue(level, 20, 40, 30, 80)
+		g.currentChunk.LaneSwitchChance = getScaledValue(g.rng, level, 0, 10, 5, 30)
+		g.currentChunk.LineSwitchChance = getScaledValue(g.rng, level, 0, 5, 5, 20)
+		g.currentChunk.RockSpawnRate = getScaledValue(g.rng, level, 10, 20, 30, 60)
+		g.currentChunk.FoodSpawnRate = getScaledValue(g.rng, level, 0, 20, 0, 10)
+		g.currentChunk.ObstacleDensity = getScaledValue(g.rng, level, 20, 40, 30, 80)
+		g.currentChunk.HazardRate = getScaledValue(g.rng, level, 0, 0, 5, 20)
 	}
 	g.chunkRemaining--
 	params := g.currentChunk
+	if g.adaptive != nil && !g.chaos {
+		g.adaptive.OnGrid(ctx.Energy)
+		params = g.adaptive.Adjust(params)
+	}
+	g.info.Params = params
 
 	// --- 1. Update Generator State (Lane switches / Pickaxe Target switch) ---
 
 	// Chance to switch Target Pickaxe Owner
 	// Use LineSwitchChance from chunk params
-	if game.RandomIntn(100) < params.LineSwitchChance {
+	if g.rng.Intn(100) < params.LineSwitchChance {
 		g.targetPickaxeOwner = 1 - g.targetPickaxeOwner
 	}
 
@@ -84,7 +266,7 @@ func (g *PathGenerator) SpawnItem(gameInst *game.Game) {
 		// Use LaneSwitchChance from chunk params
 		// Only switch if not currently in safety period
 		if g.switchSafety[i] == 0 {
-			if game.RandomIntn(100) < params.LaneSwitchChance {
+			if g.rng.Intn(100) < params.LaneSwitchChance {
 				g.pathLanes[i] = 1 - g.pathLanes[i]
 				g.switchSafety[i] = 2 // "Treat 2 grids as path" -> Safety for 2 grids
 			}
@@ -93,17 +275,32 @@ func (g *PathGenerator) SpawnItem(gameInst *game.Game) {
 
 	// --- 2. Spawn Items ---
 
-	for lineIdx := range lines {
-		line := lines[lineIdx]
+	for lineIdx := range g.pathLanes {
 		pathLane := g.pathLanes[lineIdx]
 		isSafety := g.switchSafety[lineIdx] > 0
 		isTargetOwner := (lineIdx == g.targetPickaxeOwner)
+		rec := gridRecord{pathLane: pathLane, safety: isSafety}
+		g.info.PathLanes[lineIdx] = pathLane
+		g.info.Safety[lineIdx] = isSafety
+
+		// Keep both lanes clear around a mole so either lane is a way past it
+		if g.clearRemaining[lineIdx] > 0 {
+			g.clearRemaining[lineIdx]--
+			g.record(lineIdx, rec)
+			continue
+		}
+		if !isSafety && g.rng.Intn(100) < params.HazardRate/2 && g.canPlaceMole(lineIdx) {
+			g.add(lineIdx, g.rng.Intn(2), game.ItemMole, 0)
+			rec.occupied = [2]bool{true, true}
+			g.clearRemaining[lineIdx] = moleClearGrids
+			g.record(lineIdx, rec)
+			continue
+		}
 
 		// Generate for both lanes in this line (0 and 1)
 		for lane := 0; lane < 2; lane++ {
 			// Calculate Spawn X with Variance: 0 ~ 7
-			variance := float32(game.RandomIntn(8)) // 0 to 7
-			spawnX := g.nextSpawnX + variance
+			variance := float32(g.rng.Intn(8)) // 0 to 7
 
 			isPath := (lane == pathLane)
 
@@ -117,46 +314,294 @@ func (g *PathGenerator) SpawnItem(gameInst *game.Game) {
 			if isPath {
 				if isTargetOwner {
 					// Target Path: Spawn Rock/GoldRock based on RockSpawnRate
-					r := game.RandomIntn(100)
+					r := g.rng.Intn(100)
 					if r < params.RockSpawnRate {
-						if game.RandomIntn(100) < 10 {
-							line.AddItem(game.NewGoldRock(line, spawnX, lane))
+						if g.rng.Intn(100) < 10 {
+							g.add(lineIdx, lane, game.ItemGoldRock, variance)
 						} else {
-							line.AddItem(game.NewRock(line, spawnX, lane))
+							g.add(lineIdx, lane, game.ItemRock, variance)
 						}
-					} else {
+						rec.occupied[lane] = true
+					} else if g.needsFood() {
+						g.add(lineIdx, lane, game.ItemFood, variance)
+						rec.occupied[lane] = true
 					}
 				} else {
-					if game.RandomIntn(100) < params.FoodSpawnRate {
-						line.AddItem(game.NewFood(line, spawnX, lane))
+					if g.rng.Intn(100) < params.FoodSpawnRate || g.needsFood() {
+						g.add(lineIdx, lane, game.ItemFood, variance)
+						rec.occupied[lane] = true
+					} else if g.rng.Intn(100) < PowerUpSpawnRate {
+						kind := game.PowerUpItems[g.rng.Intn(len(game.PowerUpItems))]
+						g.add(lineIdx, lane, kind, variance)
+						rec.occupied[lane] = true
 					}
 				}
 				continue
 			}
 
-			if game.RandomIntn(100) < params.ObstacleDensity {
-				r := game.RandomIntn(100)
+			if g.rng.Intn(100) < params.ObstacleDensity {
+				rec.occupied[lane] = true
+				if g.rng.Intn(100) < params.HazardRate {
+					g.add(lineIdx, lane, g.hazardKind(lineIdx, lane), variance)
+					continue
+				}
+				r := g.rng.Intn(100)
 				if r < 40 {
-					line.AddItem(game.NewRock(line, spawnX, lane))
+					g.add(lineIdx, lane, game.ItemRock, variance)
 				} else if r < 70 {
-					line.AddItem(game.NewHardRock(line, spawnX, lane))
+					g.add(lineIdx, lane, game.ItemHardRock, variance)
 				} else if r < 85 {
-					line.AddItem(game.NewGoldRock(line, spawnX, lane))
+					g.add(lineIdx, lane, game.ItemGoldRock, variance)
 				} else {
-					line.AddItem(game.NewFood(line, spawnX, lane))
+					g.add(lineIdx, lane, game.ItemFood, variance)
 				}
 			}
 		}
+		g.record(lineIdx, rec)
 	}
 
+	return g.finishGrid(ctx)
+}
+
+// finishGrid returns the plan of the column and moves on to the next one.
+func (g *PathGenerator) finishGrid(ctx game.SpawnContext) game.SpawnPlan {
+	if g.patchUnfair {
+		g.patchGrid(ctx)
+	}
+	if g.budget != nil {
+		g.budget.OnGrid(ctx.Speed, ReachableFood(g.spawned, g.info))
+	}
+	plan := game.SpawnPlan{Column: g.column, X: g.nextSpawnX, Spawns: g.spawned}
+	g.spawned = nil
+	g.column++
 	g.nextSpawnX += gridSize
+	return plan
+}
+
+// SetTemplates replaces the chunk templates (nil or empty for random chunks only).
+func (g *PathGenerator) SetTemplates(templates []ChunkTemplate) {
+	g.templates = templates
+}
+
+// spawnTemplateColumn spawns the next column of the current template.
+// The template is framed by templateClearGrids empty grids on both sides.
+func (g *PathGenerator) spawnTemplateColumn() {
+	t := g.template
+	if g.chunkRemaining <= 0 {
+		g.templateColumn = -templateClearGrids
+		g.chunkRemaining = templateClearGrids + t.Columns()
+	}
+	g.chunkRemaining--
+
+	column := g.templateColumn
+	g.templateColumn++
+	for lineIdx := range g.pathLanes {
+		// Treated as safety grids so no boulder is placed to roll back into the template
+		rec := gridRecord{pathLane: g.pathLanes[lineIdx], safety: true}
+		for lane := 0; lane < 2; lane++ {
+			kind := t.Cell(lineIdx, lane, column)
+			if kind == Empty {
+				continue
+			}
+			g.add(lineIdx, lane, kind, 0)
+			rec.occupied[lane] = true
+		}
+		if g.clearRemaining[lineIdx] > 0 {
+			g.clearRemaining[lineIdx]--
+		}
+		g.record(lineIdx, rec)
+	}
+
+	// Keep the way out clear, as after a lane switch
+	if g.chunkRemaining == 0 {
+		for i := range g.switchSafety {
+			g.switchSafety[i] = templateClearGrids + 1
+		}
+	}
+}
+
+// SetPatchUnfair enables the runtime solvability check. Each new grid is
+// checked against everything spawned before it, and obstacles of the new grid
+// are removed until a way through exists again. The check runs at the level's
+// speed and at the speed of a speed burst, as a burst can be picked up any
+// time after the grid was spawned.
+func (g *PathGenerator) SetPatchUnfair(enabled bool) {
+	g.patchUnfair = enabled
+}
+
+// add puts an item into the column being generated.
+func (g *PathGenerator) add(lineIdx, lane int, kind game.ItemKind, xOffset float32) {
+	g.spawned = append(g.spawned, game.Spawn{Column: g.column, Line: lineIdx, Lane: lane, Kind: kind, XOffset: xOffset})
+}
+
+// hazard returns the checker's view of an item of the column being generated.
+func (g *PathGenerator) hazard(s game.Spawn, playerX float32) Hazard {
+	return HazardOf(s, g.nextSpawnX+s.XOffset, playerX)
+}
+
+// fairnessCheck is the runtime solvability check at one player speed.
+type fairnessCheck struct {
+	checker   Checker
+	frontierX float32  // Player X up to which the layout has been checked (-1 before the first grid)
+	frontier  StateSet // States reachable at frontierX
+}
+
+// patchGrid checks the column being generated and removes obstacles that make it unfair.
+func (g *PathGenerator) patchGrid(ctx game.SpawnContext) {
+	playerX := g.nextSpawnX - spawnLead
+	speeds := [2]float32{ctx.Speed, ctx.Speed * game.SpeedBurstMultiplier}
+
+	obstacles := false
+	for _, s := range g.spawned {
+		obstacles = obstacles || game.ItemDefs[s.Kind].Obstacle
+	}
+	for i := range g.fairness {
+		f := &g.fairness[i]
+		if f.frontierX < 0 {
+			f.frontierX = playerX
+			f.frontier = SettledState(ctx.Lanes[0], ctx.Lanes[1], ctx.PickaxeOwner)
+		}
+		f.checker.Speed = speeds[i]
+		for _, s := range g.spawned {
+			f.checker.Add(g.hazard(s, playerX))
+		}
+	}
+
+	// A grid without obstacles cannot break a layout that was already solvable.
+	// Removing obstacles never breaks a check either, so the speeds are patched in turn.
+	if obstacles {
+		endX := g.nextSpawnX + gridSize + playerSize
+		for i := range g.fairness {
+			f := &g.fairness[i]
+			res := f.checker.Check(f.frontierX, endX, f.frontier)
+			for !res.Solvable && g.removeBlocking(res.Blocking) {
+				res = f.checker.Check(f.frontierX, endX, f.frontier)
+			}
+		}
+	}
+
+	// Advance the frontier, staying far enough behind the newest grid for boulders rolling back.
+	limit := g.nextSpawnX + gridSize - boulderClearGrids*gridSize
+	for i := range g.fairness {
+		g.fairness[i].advance(limit)
+	}
+}
+
+// advance moves the frontier up to limit. A step only ends where the moles
+// no longer leave the player in different states.
+func (f *fairnessCheck) advance(limit float32) {
+	for {
+		toX := f.frontierX + gridSize
+		var step CheckResult
+		for ; toX < limit; toX += gridSize {
+			step = f.checker.Check(f.frontierX, toX, f.frontier)
+			if !step.Solvable || step.Settled {
+				break
+			}
+		}
+		if toX >= limit {
+			break
+		}
+		f.frontierX = toX
+		if step.Solvable {
+			f.frontier = step.End
+		} else {
+			// Unfair before this grid (cannot be patched any more): start over from any lane
+			f.frontier = AllSettledStates()
+		}
+		f.checker.Prune(f.frontierX)
+	}
+}
+
+// removeBlocking removes one obstacle of the newest grid, preferring one that
+// blocks the counterexample. It returns false when nothing is left to remove.
+func (g *PathGenerator) removeBlocking(blocking []Hazard) bool {
+	pick := -1
+	for i, s := range g.spawned {
+		h := g.hazard(s, 0)
+		if !game.ItemDefs[h.Kind].Obstacle {
+			continue
+		}
+		if pick < 0 {
+			pick = i
+		}
+		for _, b := range blocking {
+			if b.SameItem(h) {
+				pick = i
+				break
+			}
+		}
+	}
+	if pick < 0 {
+		return false
+	}
+
+	for i := range g.fairness {
+		g.fairness[i].checker.Remove(g.hazard(g.spawned[pick], 0))
+	}
+	g.spawned = append(g.spawned[:pick], g.spawned[pick+1:]...)
+	return true
+}
+
+// hazardKind chooses a moving hazard for an off-path lane.
+// A stalactite lands before the player arrives, so it is as safe as a HardRock.
+// A boulder rolls back over earlier grids, so its lane must have been off-path there too.
+func (g *PathGenerator) hazardKind(lineIdx, lane int) game.ItemKind {
+	if g.rng.Intn(2) == 0 && g.canPlaceBoulder(lineIdx, lane) {
+		return game.ItemBoulder
+	}
+	return game.ItemStalactite
+}
+
+// canPlaceMole reports whether the last grids of the line are empty in both lanes.
+func (g *PathGenerator) canPlaceMole(lineIdx int) bool {
+	h := g.history[lineIdx]
+	if len(h) < moleClearGrids {
+		return false
+	}
+	for _, rec := range h[len(h)-moleClearGrids:] {
+		if rec.occupied[0] || rec.occupied[1] {
+			return false
+		}
+	}
+	return true
+}
+
+// canPlaceBoulder reports whether the lane stayed off-path for the grids a boulder rolls over.
+func (g *PathGenerator) canPlaceBoulder(lineIdx, lane int) bool {
+	h := g.history[lineIdx]
+	if len(h) < boulderClearGrids {
+		return false
+	}
+	for _, rec := range h[len(h)-boulderClearGrids:] {
+		if rec.safety || rec.pathLane == lane {
+			return false
+		}
+	}
+	return true
+}
+
+// record remembers what was generated for the line in the current grid.
+func (g *PathGenerator) record(lineIdx int, rec gridRecord) {
+	h := append(g.history[lineIdx], rec)
+	if len(h) > historyLength {
+		h = h[1:]
+	}
+	g.history[lineIdx] = h
 }
 
 func (g *PathGenerator) OnCoordinateReset(offset float32) {
 	g.nextSpawnX -= offset
+	for i := range g.fairness {
+		f := &g.fairness[i]
+		if f.frontierX >= 0 {
+			f.frontierX -= offset
+		}
+		f.checker.Shift(offset)
+	}
 }
 
-func getScaledValue(level, minV, maxV, minTarget, maxTarget int) int {
+func getScaledValue(rng *game.RNG, level, minV, maxV, minTarget, maxTarget int) int {
 	if level < 1 {
 		level = 1
 	}
@@ -177,11 +622,11 @@ func getScaledValue(level, minV, maxV, minTarget, maxTarget int) int {
 		iMin, iMax = iMax, iMin
 	}
 
-	// Ensure range is valid for RandomIntn
+	// Ensure range is valid for Intn
 	rangeSize := iMax - iMin + 1
 	if rangeSize <= 0 {
 		return iMin
 	}
 
-	return iMin + game.RandomIntn(rangeSize)
+	return iMin + rng.Intn(rangeSize)
 }
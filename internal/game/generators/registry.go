@@ -0,0 +1,99 @@
+package generators
+
+import (
+	"GolangGame251130/internal/game"
+)
+
+// Names of the generators in DefaultRegistry.
+const (
+	GeneratorPath     = "path"
+	GeneratorAssist   = "assist"
+	GeneratorTutorial = "tutorial"
+	GeneratorDaily    = "daily"
+	GeneratorChaos    = "chaos"
+)
+
+// Registry maps names to level generator factories, so experimental
+// generators can ship next to the default one. The name is recorded in
+// replays to rebuild the same generator.
+type Registry struct {
+	names     []string
+	factories map[string]game.GeneratorFactory
+}
+
+func NewRegistry() *Registry {
+	return &Registry{factories: map[string]game.GeneratorFactory{}}
+}
+
+// Register adds a factory, replacing any factory with the same name.
+func (r *Registry) Register(name string, factory game.GeneratorFactory) {
+	if _, ok := r.factories[name]; !ok {
+		r.names = append(r.names, name)
+	}
+	r.factories[name] = factory
+}
+
+// Lookup returns the factory registered under name.
+func (r *Registry) Lookup(name string) (game.GeneratorFactory, bool) {
+	factory, ok := r.factories[name]
+	return factory, ok
+}
+
+// Names returns the registered names in registration order.
+func (r *Registry) Names() []string {
+	return r.names
+}
+
+// PathOptions are the optional layers of a PathGenerator. Each generator in
+// DefaultRegistry opts in to the ones its mode wants.
+type PathOptions struct {
+	PatchUnfair  bool // Make every column passable (see SetPatchUnfair)
+	Adaptive     bool // Adjust to how the player is doing (see Adaptive)
+	EnergyBudget bool // Guarantee reachable food (see EnergyBudget)
+}
+
+// PathFactory returns a factory that builds a generator with newGen and
+// turns on the options.
+func PathFactory(newGen func(rng *game.RNG) *PathGenerator, opts PathOptions) game.GeneratorFactory {
+	return func(rng *game.RNG) game.LevelGenerator {
+		gen := newGen(rng)
+		gen.SetPatchUnfair(opts.PatchUnfair)
+		gen.SetAdaptive(opts.Adaptive)
+		gen.SetEnergyBudget(opts.EnergyBudget)
+		return gen
+	}
+}
+
+// DefaultRegistry holds the generators that ship with the game.
+var DefaultRegistry = newDefaultRegistry()
+
+func newDefaultRegistry() *Registry {
+	r := NewRegistry()
+	// The classic and daily courses only get the fairness patching, so a seed
+	// always plays the same stream, whoever plays it and however they do.
+	r.Register(GeneratorPath, PathFactory(NewPathGenerator, PathOptions{PatchUnfair: true}))
+	r.Register(GeneratorAssist, PathFactory(NewPathGenerator, PathOptions{PatchUnfair: true, Adaptive: true, EnergyBudget: true}))
+	r.Register(GeneratorTutorial, PathFactory(NewTutorialGenerator, PathOptions{PatchUnfair: true, Adaptive: true, EnergyBudget: true}))
+	r.Register(GeneratorDaily, PathFactory(NewPathGenerator, PathOptions{PatchUnfair: true}))
+	r.Register(GeneratorChaos, PathFactory(NewChaosGenerator, PathOptions{PatchUnfair: true, EnergyBudget: true}))
+	return r
+}
+
+// DefaultModes returns the modes offered on the title screen. dailySeed is
+// the seed everyone plays the daily mode with today (see game.DailySeed).
+func DefaultModes(dailySeed uint32) []game.GameMode {
+	daily := registeredMode("DAILY", GeneratorDaily)
+	daily.Seed = func() uint32 { return dailySeed }
+	return []game.GameMode{
+		registeredMode("CLASSIC", GeneratorPath),
+		registeredMode("ASSIST", GeneratorAssist),
+		registeredMode("TUTORIAL", GeneratorTutorial),
+		daily,
+		registeredMode("CHAOS", GeneratorChaos),
+	}
+}
+
+func registeredMode(name, generator string) game.GameMode {
+	factory, _ := DefaultRegistry.Lookup(generator)
+	return game.GameMode{Name: name, Generator: generator, Factory: factory}
+}
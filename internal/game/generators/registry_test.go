@@ -0,0 +1,43 @@
+package generators
+
+import (
+	"reflect"
+	"testing"
+
+	"GolangGame251130/internal/game"
+)
+
+// kindItem is an item outside any line, for the outcome hooks.
+type kindItem struct {
+	game.Item
+	kind game.ItemKind
+}
+
+func (i kindItem) Kind() game.ItemKind { return i.kind }
+
+// TestDailyIgnoresPlayer checks that the daily generator plans the same
+// stream for a player who takes every hit as for one who takes none.
+func TestDailyIgnoresPlayer(t *testing.T) {
+	factory, ok := DefaultRegistry.Lookup(GeneratorDaily)
+	if !ok {
+		t.Fatalf("%s generator is not registered", GeneratorDaily)
+	}
+	rock := kindItem{kind: game.ItemHardRock}
+
+	for _, seed := range []uint32{1, 2, 3, 1000} {
+		struggling := factory(game.NewRNG(seed))
+		expert := factory(game.NewRNG(seed))
+		for n := 0; n < 400; n++ {
+			ctx := game.NewSpawnContext(1)
+			ctx.Energy = 10
+			struggling.OnItemResolved(0, rock, game.ItemHit)
+			got := struggling.PlanSpawn(ctx)
+
+			ctx.Energy = 100
+			want := expert.PlanSpawn(ctx)
+			if !reflect.DeepEqual(got, want) {
+				t.Fatalf("seed %d: column %d differs: %+v and %+v", seed, n, got, want)
+			}
+		}
+	}
+}
@@ -0,0 +1,494 @@
+package generators
+
+import (
+	"GolangGame251130/internal/game"
+)
+
+// Solvability checker.
+//
+// It replays a stretch of spawned items frame by frame and tracks every state
+// the two gophers can be in (lane position, target lane, pickaxe owner). A
+// stretch is solvable when at least one state survives without touching an
+// obstacle: HardRocks and other unbreakable items always hurt, breakable rocks
+// hurt unless the gopher in that line holds the single pickaxe.
+//
+// The model follows Game.Update: buttons are applied first, then the players
+// move (lane switch at PlayerLaneSpeed, i.e. 4px per frame), then collisions
+// are tested. Collisions use a slack of one frame of travel so that the
+// checker is conservative about where the real frames land.
+//
+// Moving hazards:
+//   - Stalactites land before the player is HazardReactionMargin away, so they
+//     are treated as static.
+//   - Boulders roll left at BoulderSpeed from the moment they were spawned.
+//   - Moles stop hopping HazardReactionMargin before the player. Their final
+//     lane is chosen adversarially: both lanes must be survivable, each with
+//     its own set of states from there on.
+
+const (
+	laneSteps  = 5 // Lane position in 4px steps: 0 = lane 0, 4 = lane 1
+	stateCount = laneSteps * 2 * laneSteps * 2 * 2
+	playerSize = 16
+	frameDelta = float32(1.0 / 60.0)
+)
+
+// Hazard is one spawned item as seen by the checker.
+type Hazard struct {
+	Line, Lane   int
+	X            float32 // World X when spawned
+	Kind         game.ItemKind
+	SpawnPlayerX float32 // Player X when spawned (moving items start from here)
+}
+
+// StateSet is a set of player states.
+type StateSet [stateCount]bool
+
+// Empty reports whether no state is in the set.
+func (s *StateSet) Empty() bool {
+	for _, ok := range s {
+		if ok {
+			return false
+		}
+	}
+	return true
+}
+
+// SettledState returns the set holding a single state with both gophers
+// standing in the given lanes.
+func SettledState(lane0, lane1, owner int) StateSet {
+	var s StateSet
+	s[encodeState(lane0*(laneSteps-1), lane0, lane1*(laneSteps-1), lane1, owner)] = true
+	return s
+}
+
+// AllSettledStates returns every state with both gophers standing in a lane.
+func AllSettledStates() StateSet {
+	var s StateSet
+	for lane0 := 0; lane0 < 2; lane0++ {
+		for lane1 := 0; lane1 < 2; lane1++ {
+			for owner := 0; owner < 2; owner++ {
+				s[encodeState(lane0*(laneSteps-1), lane0, lane1*(laneSteps-1), lane1, owner)] = true
+			}
+		}
+	}
+	return s
+}
+
+func encodeState(y0, t0, y1, t1, owner int) int {
+	return (((y0*2+t0)*laneSteps+y1)*2+t1)*2 + owner
+}
+
+func decodeState(s int) (y0, t0, y1, t1, owner int) {
+	owner = s % 2
+	s /= 2
+	t1 = s % 2
+	s /= 2
+	y1 = s % laneSteps
+	s /= laneSteps
+	t0 = s % 2
+	y0 = s / 2
+	return
+}
+
+// stepLane moves a lane position one frame towards its target lane.
+func stepLane(y, target int) int {
+	goal := target * (laneSteps - 1)
+	if y < goal {
+		return y + 1
+	}
+	if y > goal {
+		return y - 1
+	}
+	return y
+}
+
+// CheckResult is the outcome of Checker.Check.
+type CheckResult struct {
+	Solvable bool
+	End      StateSet // States reachable at the end of the stretch
+	Settled  bool     // End is exact: no mole left the player in different states
+
+	// Counterexample (when not solvable)
+	FailX    float32  // Player X where every state was hit
+	Blocking []Hazard // Obstacles overlapping the player there (moles in their assumed lane, X as spawned)
+}
+
+// Checker checks stretches of spawned items.
+type Checker struct {
+	Speed   float32 // Player speed (px/s)
+	Hazards []Hazard
+}
+
+// Add appends a hazard; items that can never hurt are ignored.
+func (c *Checker) Add(h Hazard) {
+	if game.ItemDefs[h.Kind].Obstacle {
+		c.Hazards = append(c.Hazards, h)
+	}
+}
+
+// SameItem reports whether both hazards describe the same spawned item.
+// A mole's lane is ignored, as counterexamples report the lane it was assumed to take.
+func (h Hazard) SameItem(o Hazard) bool {
+	if h.Line != o.Line || h.Kind != o.Kind || h.X != o.X {
+		return false
+	}
+	return h.Kind == game.ItemMole || h.Lane == o.Lane
+}
+
+// Remove drops a hazard that was patched out of the layout.
+func (c *Checker) Remove(h Hazard) {
+	for i := range c.Hazards {
+		if c.Hazards[i].SameItem(h) {
+			c.Hazards = append(c.Hazards[:i], c.Hazards[i+1:]...)
+			return
+		}
+	}
+}
+
+// Prune drops hazards that are entirely behind the player X.
+func (c *Checker) Prune(playerX float32) {
+	kept := c.Hazards[:0]
+	for _, h := range c.Hazards {
+		if c.hazardX(h, playerX)+playerSize+c.slack() >= playerX {
+			kept = append(kept, h)
+		}
+	}
+	c.Hazards = kept
+}
+
+// Shift moves every hazard by -offset (see LevelGenerator.OnCoordinateReset).
+func (c *Checker) Shift(offset float32) {
+	for i := range c.Hazards {
+		c.Hazards[i].X -= offset
+		c.Hazards[i].SpawnPlayerX -= offset
+	}
+}
+
+func (c *Checker) slack() float32 {
+	return c.Speed * frameDelta
+}
+
+// hazardX returns where the hazard is when the player is at playerX.
+func (c *Checker) hazardX(h Hazard, playerX float32) float32 {
+	if h.Kind != game.ItemBoulder || c.Speed <= 0 {
+		return h.X
+	}
+	elapsed := (playerX - h.SpawnPlayerX) / c.Speed
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	return h.X - game.BoulderSpeed*elapsed
+}
+
+// moleVariant is a reachable set under one assumption about the active moles.
+type moleVariant struct {
+	lanes uint32 // Bit i: lane of the i-th active mole
+	set   StateSet
+}
+
+// Check runs the player from fromX to toX starting in any of the start states.
+func (c *Checker) Check(fromX, toX float32, start StateSet) CheckResult {
+	var active []int // Hazard indices of moles the player is close to
+	done := make([]bool, len(c.Hazards))
+	variants := []moleVariant{{set: start}}
+
+	if start.Empty() {
+		return CheckResult{FailX: fromX}
+	}
+
+	step := c.Speed * frameDelta
+	if step <= 0 {
+		return CheckResult{Solvable: true, End: start, Settled: true}
+	}
+
+	// Frames are placed on multiples of step so that checks of overlapping stretches agree
+	for k := int(fromX/step) + 1; float32(k-1)*step < toX; k++ {
+		x := float32(k) * step
+
+		// Moles settle HazardReactionMargin before the player; from then on either lane is possible.
+		for i, h := range c.Hazards {
+			if h.Kind != game.ItemMole || done[i] || containsInt(active, i) {
+				continue
+			}
+			if x >= h.X-game.HazardReactionMargin {
+				bit := uint32(1) << uint(len(active))
+				active = append(active, i)
+				n := len(variants)
+				for v := 0; v < n; v++ {
+					variants = append(variants, moleVariant{lanes: variants[v].lanes | bit, set: variants[v].set})
+				}
+			}
+		}
+
+		for v := range variants {
+			blocked := c.blockedAt(x, active, variants[v].lanes)
+			variants[v].set = advance(variants[v].set, &blocked)
+			if variants[v].set.Empty() {
+				return CheckResult{
+					FailX:    x,
+					Blocking: c.overlapping(x, active, variants[v].lanes),
+				}
+			}
+		}
+
+		if len(variants) > 1 {
+			variants = dropSupersets(variants)
+		}
+
+		// Once a mole is behind the player it no longer needs its own bit.
+		for k := 0; k < len(active); k++ {
+			i := active[k]
+			if x <= c.Hazards[i].X+playerSize+c.slack() {
+				continue
+			}
+			done[i] = true
+			variants = mergeMole(variants, k)
+			active = append(active[:k], active[k+1:]...)
+			k--
+		}
+	}
+
+	return CheckResult{
+		Solvable: true,
+		End:      endStates(variants),
+		Settled:  len(active) == 0 && len(variants) == 1,
+	}
+}
+
+// mergeMole drops the k-th active mole from the variants. The player has seen
+// where the mole settled, so the variants stay separate until they converge.
+func mergeMole(variants []moleVariant, k int) []moleVariant {
+	bit := uint32(1) << uint(k)
+	low := bit - 1
+	for i := range variants {
+		v := &variants[i]
+		v.lanes = v.lanes&low | (v.lanes>>1)&^low
+	}
+	return dropSupersets(variants)
+}
+
+// dropSupersets removes a variant when another one with the same active mole
+// lanes reaches a subset of its states: whatever is solvable from the subset
+// is solvable from the superset too.
+func dropSupersets(variants []moleVariant) []moleVariant {
+	kept := variants[:0]
+	for _, v := range variants {
+		keep := true
+		for i := 0; i < len(kept); i++ {
+			w := &kept[i]
+			if w.lanes != v.lanes {
+				continue
+			}
+			if w.set.subsetOf(&v.set) {
+				keep = false
+				break
+			}
+			if v.set.subsetOf(&w.set) {
+				kept = append(kept[:i], kept[i+1:]...)
+				i--
+			}
+		}
+		if keep {
+			kept = append(kept, v)
+		}
+	}
+	return kept
+}
+
+// subsetOf reports whether every state of s is also in t.
+func (s *StateSet) subsetOf(t *StateSet) bool {
+	for i, ok := range s {
+		if ok && !t[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// blockTable holds, for each line, lane position and pickaxe ownership, whether the player is hit.
+type blockTable [2][laneSteps][2]bool
+
+// blockedAt builds the block table for the player at x.
+func (c *Checker) blockedAt(x float32, active []int, moleLanes uint32) blockTable {
+	var t blockTable
+	slack := c.slack()
+	for i, h := range c.Hazards {
+		hx := c.hazardX(h, x)
+		if hx > x+playerSize+slack || hx+playerSize+slack < x {
+			continue
+		}
+		def := &game.ItemDefs[h.Kind]
+		box := def.GetHitbox()
+		left := hx + float32(box.X)
+		if left >= x+playerSize+slack || left+float32(box.W)+slack <= x {
+			continue
+		}
+		lane := moleLane(h, i, active, moleLanes)
+		top := lane*playerSize + box.Y
+		for y := 0; y < laneSteps; y++ {
+			py := y * 4
+			if top >= py+playerSize || top+box.H <= py {
+				continue
+			}
+			for pickaxe := 0; pickaxe < 2; pickaxe++ {
+				if def.Breakable && pickaxe == 1 {
+					continue
+				}
+				t[h.Line][y][pickaxe] = true
+			}
+		}
+	}
+	return t
+}
+
+// overlapping lists the obstacles near the player at x (for counterexamples).
+func (c *Checker) overlapping(x float32, active []int, moleLanes uint32) []Hazard {
+	var hs []Hazard
+	slack := c.slack()
+	for i, h := range c.Hazards {
+		hx := c.hazardX(h, x)
+		if hx >= x+playerSize+slack || hx+playerSize+slack <= x {
+			continue
+		}
+		h.Lane = moleLane(h, i, active, moleLanes)
+		hs = append(hs, h)
+	}
+	return hs
+}
+
+// moleLane returns the lane assumed for a hazard (moles follow the variant).
+func moleLane(h Hazard, i int, active []int, moleLanes uint32) int {
+	for k, idx := range active {
+		if idx == i {
+			return int(moleLanes>>uint(k)) & 1
+		}
+	}
+	return h.Lane
+}
+
+// transition is where one button combination takes a state in one frame.
+type transition struct {
+	next               int
+	y0, y1             int // Lane positions after the move
+	pickaxe0, pickaxe1 int // 1 for the gopher holding the pickaxe
+}
+
+// transitions holds every state's transitions, indexed by button combination
+// (bit 0: switch line 0, bit 1: switch line 1, bit 2: pass the pickaxe).
+var transitions = buildTransitions()
+
+func buildTransitions() *[stateCount][8]transition {
+	var t [stateCount][8]transition
+	for s := range t {
+		y0, t0, y1, t1, owner := decodeState(s)
+		for buttons := 0; buttons < 8; buttons++ {
+			nt0 := t0 ^ buttons&1
+			nt1 := t1 ^ (buttons>>1)&1
+			nOwner := owner ^ (buttons>>2)&1
+			ny0 := stepLane(y0, nt0)
+			ny1 := stepLane(y1, nt1)
+			t[s][buttons] = transition{
+				next:     encodeState(ny0, nt0, ny1, nt1, nOwner),
+				y0:       ny0,
+				y1:       ny1,
+				pickaxe0: 1 - nOwner,
+				pickaxe1: nOwner,
+			}
+		}
+	}
+	return &t
+}
+
+// advance applies every button combination for one frame.
+func advance(from StateSet, blocked *blockTable) StateSet {
+	var to StateSet
+	for s, ok := range from {
+		if !ok {
+			continue
+		}
+		for _, tr := range &transitions[s] {
+			if blocked[0][tr.y0][tr.pickaxe0] || blocked[1][tr.y1][tr.pickaxe1] {
+				continue
+			}
+			to[tr.next] = true
+		}
+	}
+	return to
+}
+
+// endStates returns the states reachable whatever the moles did. When the
+// variants share no state, the smallest variant is used instead.
+func endStates(variants []moleVariant) StateSet {
+	end := variants[0].set
+	for _, v := range variants[1:] {
+		for s := range end {
+			end[s] = end[s] && v.set[s]
+		}
+	}
+	if !end.Empty() {
+		return end
+	}
+	best, bestCount := 0, stateCount+1
+	for i, v := range variants {
+		if n := v.set.count(); n < bestCount {
+			best, bestCount = i, n
+		}
+	}
+	return variants[best].set
+}
+
+// count returns the number of states in the set.
+func (s *StateSet) count() int {
+	n := 0
+	for _, ok := range s {
+		if ok {
+			n++
+		}
+	}
+	return n
+}
+
+func containsInt(xs []int, v int) bool {
+	for _, x := range xs {
+		if x == v {
+			return true
+		}
+	}
+	return false
+}
+
+// HazardOf converts a spawn at world X x into the checker's view.
+func HazardOf(s game.Spawn, x, playerX float32) Hazard {
+	return Hazard{
+		Line:         s.Line,
+		Lane:         s.Lane,
+		X:            x,
+		Kind:         s.Kind,
+		SpawnPlayerX: playerX,
+	}
+}
+
+// CollectPlans generates the given number of grids from gen without running
+// the game, as if the gophers stayed where ctx has them.
+func CollectPlans(gen game.LevelGenerator, ctx game.SpawnContext, grids int) []game.SpawnPlan {
+	plans := make([]game.SpawnPlan, 0, grids)
+	for n := 0; n < grids; n++ {
+		plans = append(plans, gen.PlanSpawn(ctx))
+	}
+	return plans
+}
+
+// CollectHazards generates the given number of grids from gen (see
+// CollectPlans) and returns every spawned item as a hazard. Moving items are
+// assumed to start when the player is spawnLead behind them, as they do in a
+// running game.
+func CollectHazards(gen game.LevelGenerator, ctx game.SpawnContext, grids int) []Hazard {
+	hs := []Hazard{}
+	for _, plan := range CollectPlans(gen, ctx, grids) {
+		for _, s := range plan.Spawns {
+			x := plan.SpawnX(s)
+			hs = append(hs, HazardOf(s, x, x-spawnLead))
+		}
+	}
+	return hs
+}
@@ -0,0 +1,157 @@
+package generators
+
+import (
+	"testing"
+
+	"GolangGame251130/internal/game"
+)
+
+// newChecker returns a checker at the level 1 speed with the hazards.
+func newChecker(hazards ...Hazard) *Checker {
+	c := &Checker{Speed: game.LevelSpeed(1)}
+	for _, h := range hazards {
+		c.Add(h)
+	}
+	return c
+}
+
+func TestCheckerBlocked(t *testing.T) {
+	tests := []struct {
+		name    string
+		hazards []Hazard
+	}{
+		{"hard rocks in both lanes", []Hazard{
+			{Line: 0, Lane: 0, X: 200, Kind: game.ItemHardRock},
+			{Line: 0, Lane: 1, X: 200, Kind: game.ItemHardRock},
+		}},
+		// Only one of the lines holds the pickaxe
+		{"rocks in every lane", []Hazard{
+			{Line: 0, Lane: 0, X: 200, Kind: game.ItemRock},
+			{Line: 0, Lane: 1, X: 200, Kind: game.ItemRock},
+			{Line: 1, Lane: 0, X: 200, Kind: game.ItemRock},
+			{Line: 1, Lane: 1, X: 200, Kind: game.ItemRock},
+		}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			res := newChecker(tt.hazards...).Check(0, 300, AllSettledStates())
+			if res.Solvable {
+				t.Fatal("solvable")
+			}
+			if res.FailX < 200-2*playerSize || res.FailX > 200+playerSize {
+				t.Errorf("fails at X %g, want near 200", res.FailX)
+			}
+			if len(res.Blocking) == 0 {
+				t.Fatal("no blocking obstacles")
+			}
+			for _, b := range res.Blocking {
+				found := false
+				for _, h := range tt.hazards {
+					found = found || b.SameItem(h)
+				}
+				if !found {
+					t.Errorf("blocking %+v is not in the layout", b)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckerLaneSwitch(t *testing.T) {
+	// The upper gopher starts in lane 0 and has to move to lane 1.
+	c := newChecker(
+		Hazard{Line: 0, Lane: 0, X: 200, Kind: game.ItemHardRock},
+		Hazard{Line: 1, Lane: 1, X: 200, Kind: game.ItemHardRock},
+	)
+	if res := c.Check(0, 300, SettledState(0, 0, 0)); !res.Solvable {
+		t.Fatalf("unsolvable at X %g: %+v", res.FailX, res.Blocking)
+	}
+
+	// Alongside the rocks, only states with the upper gopher out of lane 0 are left.
+	res := c.Check(0, 200, SettledState(0, 0, 0))
+	if !res.Solvable {
+		t.Fatalf("unsolvable at X %g: %+v", res.FailX, res.Blocking)
+	}
+	for s, ok := range res.End {
+		if !ok {
+			continue
+		}
+		if y0, _, y1, _, _ := decodeState(s); y0 == 0 || y1 != 0 {
+			t.Errorf("end state %d has the gophers at lane positions %d/%d", s, y0, y1)
+		}
+	}
+
+	// Without time to switch the same layout cannot be passed.
+	res = c.Check(200-playerSize-2, 300, SettledState(0, 0, 0))
+	if res.Solvable {
+		t.Error("solvable without time to switch lanes")
+	}
+}
+
+func TestCheckerPickaxe(t *testing.T) {
+	from := float32(200 - playerSize - 2) // Too close to switch lanes
+	tests := []struct {
+		name     string
+		hazards  []Hazard
+		solvable bool
+	}{
+		// Whoever holds the pickaxe can pass it on in time
+		{"one rock", []Hazard{{Line: 1, Lane: 0, X: 200, Kind: game.ItemRock}}, true},
+		{"rocks in both lines", []Hazard{
+			{Line: 0, Lane: 0, X: 200, Kind: game.ItemRock},
+			{Line: 1, Lane: 0, X: 200, Kind: game.ItemRock},
+		}, false},
+		{"hard rock", []Hazard{{Line: 1, Lane: 0, X: 200, Kind: game.ItemHardRock}}, false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for owner := 0; owner < 2; owner++ {
+				res := newChecker(tt.hazards...).Check(from, 300, SettledState(0, 0, owner))
+				if res.Solvable != tt.solvable {
+					t.Errorf("owner %d: solvable %v, want %v", owner, res.Solvable, tt.solvable)
+				}
+			}
+		})
+	}
+}
+
+func TestAllSettledStates(t *testing.T) {
+	all := AllSettledStates()
+	if got := all.count(); got != 8 {
+		t.Errorf("%d settled states, want 8", got)
+	}
+	for lane0 := 0; lane0 < 2; lane0++ {
+		for lane1 := 0; lane1 < 2; lane1++ {
+			for owner := 0; owner < 2; owner++ {
+				s := SettledState(lane0, lane1, owner)
+				if !s.subsetOf(&all) {
+					t.Errorf("lanes %d/%d owner %d is not settled", lane0, lane1, owner)
+				}
+			}
+		}
+	}
+}
+
+func TestHazardOf(t *testing.T) {
+	s := game.Spawn{Column: 3, Line: 1, Lane: 0, Kind: game.ItemBoulder, XOffset: 5}
+	h := HazardOf(s, 480, 100)
+	want := Hazard{Line: 1, Lane: 0, X: 480, Kind: game.ItemBoulder, SpawnPlayerX: 100}
+	if h != want {
+		t.Errorf("got %+v, want %+v", h, want)
+	}
+}
+
+func TestCollectPlans(t *testing.T) {
+	factory, _ := DefaultRegistry.Lookup(GeneratorPath)
+	plans := CollectPlans(factory(game.NewRNG(1)), game.NewSpawnContext(1), 50)
+	if len(plans) != 50 {
+		t.Fatalf("%d plans, want 50", len(plans))
+	}
+	for i, plan := range plans {
+		if plan.Column != i {
+			t.Errorf("plan %d is column %d", i, plan.Column)
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package generators
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"GolangGame251130/internal/game"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden streams in testdata")
+
+// streamSeeds are the recorded seeds. Adding one changes every golden file.
+var streamSeeds = []uint32{1, 2, 3, 1000}
+
+const (
+	streamLevels = 10
+	streamGrids  = 80 // Grid columns recorded per stream
+)
+
+// TestStreams records the items the path generator produces for a fixed set
+// of seeds at levels 1-10 and compares them with the golden text files in
+// testdata, so a change to the generator that reshuffles the streams does not
+// go unnoticed.
+//
+// Each level has one file with a section per seed and a line per grid column:
+// the column, its X, and the item in each line and lane with its X offset
+// ("Rock+3"). A column without items is left out. The generator runs without
+// a game, with the gophers in lane 0 and the level's speed (see CollectPlans).
+//
+// A differing stream is written next to the golden one as <name>.actual.txt;
+// run with -update after an intentional change to the generator to rewrite
+// the golden files.
+func TestStreams(t *testing.T) {
+	factory, ok := DefaultRegistry.Lookup(GeneratorPath)
+	if !ok {
+		t.Fatalf("%s generator is not registered", GeneratorPath)
+	}
+
+	for level := 1; level <= streamLevels; level++ {
+		var buf bytes.Buffer
+		for i, seed := range streamSeeds {
+			if i > 0 {
+				buf.WriteString("\n")
+			}
+			recordStream(&buf, factory, seed, level, streamGrids)
+		}
+
+		name := fmt.Sprintf("%s_level%02d", GeneratorPath, level)
+		t.Run(name, func(t *testing.T) {
+			checkStream(t, filepath.Join("testdata", name+".txt"), buf.Bytes())
+		})
+	}
+}
+
+// recordStream writes the stream of one seed at one level.
+func recordStream(buf *bytes.Buffer, factory game.GeneratorFactory, seed uint32, level, grids int) {
+	gen := factory(game.NewRNG(seed))
+	gen.OnLevelUp(level)
+
+	fmt.Fprintf(buf, "# seed %d level %d\n", seed, level)
+	for _, plan := range CollectPlans(gen, game.NewSpawnContext(level), grids) {
+		if len(plan.Spawns) == 0 {
+			continue
+		}
+		var cells [2][2]string
+		for _, s := range plan.Spawns {
+			cells[s.Line][s.Lane] = fmt.Sprintf("%s+%g", game.ItemDefs[s.Kind].Name, s.XOffset)
+		}
+		fmt.Fprintf(buf, "%4d %6g |", plan.Column, plan.X)
+		for line := range cells {
+			for lane := range cells[line] {
+				cell := cells[line][lane]
+				if cell == "" {
+					cell = "."
+				}
+				fmt.Fprintf(buf, " %-12s", cell)
+			}
+			if line == 0 {
+				buf.WriteString("|")
+			}
+		}
+		buf.Truncate(len(bytes.TrimRight(buf.Bytes(), " ")))
+		buf.WriteString("\n")
+	}
+}
+
+// checkStream compares a stream with the golden file at path (or rewrites it).
+func checkStream(t *testing.T, path string, actual []byte) {
+	t.Helper()
+	if *update {
+		if err := os.WriteFile(path, actual, 0o644); err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("updated %s", path)
+		return
+	}
+
+	golden, err := os.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bytes.Equal(golden, actual) {
+		return
+	}
+
+	actualPath := strings.TrimSuffix(path, ".txt") + ".actual.txt"
+	if err := os.WriteFile(actualPath, actual, 0o644); err != nil {
+		t.Fatal(err)
+	}
+	n, want, got := firstDiff(string(golden), string(actual))
+	t.Errorf("%s: line %d differs (see %s)\nwant: %s\ngot:  %s", path, n, actualPath, want, got)
+}
+
+// firstDiff returns the first differing line (1-based) of a and b.
+func firstDiff(a, b string) (int, string, string) {
+	la := strings.Split(a, "\n")
+	lb := strings.Split(b, "\n")
+	for i := 0; ; i++ {
+		var x, y string
+		if i < len(la) {
+			x = la[i]
+		}
+		if i < len(lb) {
+			y = lb[i]
+		}
+		if x != y || i >= len(la) || i >= len(lb) {
+			return i + 1, x, y
+		}
+	}
+}
@@ -0,0 +1,66 @@
+// Code generated by cmd/chunkgen from chunks/*.chunk. DO NOT EDIT.
+
+package generators
+
+import (
+	"GolangGame251130/internal/game"
+)
+
+// DefaultChunkTemplates are the set-pieces PathGenerator splices in by default,
+// generated from the chunk files in chunks/.
+var DefaultChunkTemplates = []ChunkTemplate{
+	{
+		// food_cache.chunk
+		// Food for both gophers while the game is still slow
+		Name:     "food cache",
+		MinLevel: 1,
+		MaxLevel: 4,
+		Weight:   2,
+		Grid: [2][2][]game.ItemKind{
+			{
+				{game.ItemFood, Empty, game.ItemFood, Empty, game.ItemFood, Empty},
+				{Empty, game.ItemFood, Empty, game.ItemFood, Empty, game.ItemFood},
+			},
+			{
+				{Empty, game.ItemFood, Empty, game.ItemFood, Empty, game.ItemFood},
+				{game.ItemFood, Empty, game.ItemFood, Empty, game.ItemFood, Empty},
+			},
+		},
+	},
+	{
+		// gold_rock_gauntlet.chunk
+		// Line 0 breaks a row of gold rocks while line 1 weaves between hard rocks
+		Name:     "gold rock gauntlet",
+		MinLevel: 2,
+		MaxLevel: 0,
+		Weight:   3,
+		Grid: [2][2][]game.ItemKind{
+			{
+				{game.ItemGoldRock, Empty, game.ItemGoldRock, Empty, game.ItemGoldRock, Empty, game.ItemGoldRock, Empty},
+				{game.ItemHardRock, game.ItemHardRock, game.ItemHardRock, game.ItemHardRock, game.ItemHardRock, game.ItemHardRock, game.ItemHardRock, game.ItemHardRock},
+			},
+			{
+				{Empty, game.ItemHardRock, Empty, Empty, Empty, game.ItemHardRock, Empty, Empty},
+				{Empty, Empty, Empty, game.ItemHardRock, Empty, Empty, Empty, game.ItemHardRock},
+			},
+		},
+	},
+	{
+		// pickaxe_relay.chunk
+		// Walls of rocks across both lanes: the pickaxe has to go back and forth
+		Name:     "pickaxe relay",
+		MinLevel: 3,
+		MaxLevel: 0,
+		Weight:   2,
+		Grid: [2][2][]game.ItemKind{
+			{
+				{game.ItemRock, Empty, Empty, Empty, Empty, Empty, Empty, game.ItemRock, Empty},
+				{game.ItemRock, Empty, Empty, Empty, Empty, Empty, Empty, game.ItemRock, Empty},
+			},
+			{
+				{Empty, Empty, game.ItemFood, game.ItemRock, Empty, Empty, Empty, Empty, game.ItemFood},
+				{Empty, Empty, Empty, game.ItemRock, Empty, Empty, Empty, Empty, Empty},
+			},
+		},
+	},
+}
@@ -0,0 +1,73 @@
+package generators
+
+import (
+	"GolangGame251130/internal/game"
+)
+
+// TutorialLessons are the set-pieces the Tutorial mode plays in order, one
+// mechanic at a time, before it carries on like the Classic mode.
+var TutorialLessons = []ChunkTemplate{
+	{
+		// Switch lanes to eat food
+		Name: "lesson: food",
+		Grid: [2][2][]game.ItemKind{
+			{
+				{game.ItemFood, Empty, Empty, Empty, game.ItemFood, Empty, Empty, Empty},
+				{Empty, Empty, game.ItemFood, Empty, Empty, Empty, game.ItemFood, Empty},
+			},
+			{
+				{Empty, Empty, game.ItemFood, Empty, Empty, Empty, game.ItemFood, Empty},
+				{game.ItemFood, Empty, Empty, Empty, game.ItemFood, Empty, Empty, Empty},
+			},
+		},
+	},
+	{
+		// Hard rocks cannot be broken; dodge them
+		Name: "lesson: hard rocks",
+		Grid: [2][2][]game.ItemKind{
+			{
+				{game.ItemHardRock, Empty, Empty, Empty, Empty, Empty, game.ItemHardRock, Empty, Empty, Empty},
+				{Empty, Empty, Empty, game.ItemHardRock, Empty, Empty, Empty, Empty, Empty, Empty},
+			},
+			{
+				{Empty, Empty, Empty, game.ItemHardRock, Empty, Empty, Empty, Empty, Empty, Empty},
+				{game.ItemHardRock, Empty, Empty, Empty, Empty, Empty, game.ItemHardRock, Empty, Empty, Empty},
+			},
+		},
+	},
+	{
+		// The gopher with the pickaxe breaks rocks
+		Name: "lesson: pickaxe",
+		Grid: [2][2][]game.ItemKind{
+			{
+				{game.ItemRock, Empty, Empty, Empty, game.ItemRock, Empty, Empty, Empty},
+				{game.ItemRock, Empty, Empty, Empty, game.ItemRock, Empty, Empty, Empty},
+			},
+			{
+				{Empty, game.ItemFood, Empty, Empty, Empty, game.ItemFood, Empty, Empty},
+			},
+		},
+	},
+	{
+		// Pass the pickaxe to the other gopher in time
+		Name: "lesson: pass the pickaxe",
+		Grid: [2][2][]game.ItemKind{
+			{
+				{game.ItemRock, Empty, Empty, Empty, Empty, Empty},
+				{game.ItemRock, Empty, Empty, Empty, Empty, Empty},
+			},
+			{
+				{Empty, Empty, Empty, Empty, game.ItemRock, Empty},
+				{Empty, Empty, Empty, Empty, game.ItemRock, Empty},
+			},
+		},
+	},
+}
+
+// NewTutorialGenerator returns a PathGenerator that starts with TutorialLessons
+// instead of random chunks. Other templates only appear after the lessons.
+func NewTutorialGenerator(rng *game.RNG) *PathGenerator {
+	gen := NewPathGenerator(rng)
+	gen.lessons = TutorialLessons
+	return gen
+}
@@ -0,0 +1,215 @@
+package game
+
+// 動く障害物（Item.Update で動きや予兆を持つ）
+//
+// どれも「プレイヤーが近づいたら動きを確定させる」ことで、
+// 反応する時間（HazardReactionMargin ぶんの距離）を必ず残す。
+
+// HazardReactionMargin は動きが確定してからプレイヤーが届くまでに残す距離（ピクセル）
+// レーン移動は16ピクセルを4フレームで終えるので、最高速度でも十分に間に合う
+const HazardReactionMargin = 40.0
+
+// --- Mole ---
+
+// モグラの動き
+const (
+	moleIdleTime      = 0.8  // 次の予兆までの時間（秒）
+	moleTelegraphTime = 0.5  // 予兆（震える）の時間（秒）
+	moleHopSpeed      = 80.0 // レーン移動の速さ（ピクセル/秒）
+	moleHopTime       = 16.0 / moleHopSpeed
+)
+
+type moleState int
+
+const (
+	moleIdle moleState = iota
+	moleTelegraph
+	moleHop
+)
+
+// Mole は予兆のあとにラインの2つのレーンを行き来するモグラ
+// プレイヤーが近づくと跳ねるのをやめる
+type Mole struct {
+	StandardItem
+	lane  int
+	state moleState
+	timer float32
+	anim  float32
+}
+
+func NewMole(line *Line, x float32, lane int) *Mole {
+	return &Mole{
+		StandardItem: *NewItem(ItemMole, line, x, lane),
+		lane:         lane,
+		timer:        moleIdleTime,
+	}
+}
+
+func (m *Mole) Update(dt float32) {
+	m.anim += dt
+
+	switch m.state {
+	case moleIdle:
+		m.timer -= dt
+		if m.timer <= 0 && m.canHop() {
+			m.state = moleTelegraph
+			m.timer = moleTelegraphTime
+		}
+	case moleTelegraph:
+		m.timer -= dt
+		if m.timer <= 0 {
+			m.state = moleHop
+			m.lane = 1 - m.lane
+		}
+	case moleHop:
+		targetY := m.line.GetLaneY(m.lane)
+		move := float32(moleHopSpeed) * dt
+		if m.Position.Y < targetY {
+			m.Position.Y = min(m.Position.Y+move, targetY)
+		} else {
+			m.Position.Y = max(m.Position.Y-move, targetY)
+		}
+		if m.Position.Y == targetY {
+			m.state = moleIdle
+			m.timer = moleIdleTime
+		}
+	}
+}
+
+// canHop は跳ね終わってもプレイヤーが反応できる距離があるかを返す
+// 予兆の間にスピードバーストが始まっても間に合うように、バースト中の速さで判断する
+func (m *Mole) canHop() bool {
+	dist := m.Position.X - m.line.player.position.X
+	speed := m.line.game.speed * SpeedBurstMultiplier
+	return dist > speed*(moleTelegraphTime+moleHopTime)+HazardReactionMargin
+}
+
+func (m *Mole) Draw(r Renderer, camera *Camera) {
+	screenPos := camera.WorldToScreen(m.Position)
+	x, y := Round(screenPos.X), Round(screenPos.Y)
+
+	// 予兆: 震えて「!」を出す
+	if m.state == moleTelegraph {
+		if int(m.anim*30)%2 == 0 {
+			x++
+		} else {
+			x--
+		}
+		DrawOutlinedText(r, "!", x+6, y-8, 4, 0)
+	}
+
+	// 土の盛り上がり
+	r.Rect(x+1, y+12, 14, 4, 3)
+	// 頭
+	r.Circ(x+8, y+8, 6, 15)
+	// 目と鼻
+	r.Pix(x+6, y+6, 12)
+	r.Pix(x+10, y+6, 12)
+	r.Rect(x+7, y+9, 3, 2, 2)
+}
+
+// --- Stalactite ---
+
+// 鍾乳石の動き
+const (
+	stalactiteHang     = 24.0 // 落ちる前のレーンからの高さ（ピクセル）
+	stalactiteFallTime = 0.4  // 落ちるのにかかる時間（秒）
+)
+
+// Stalactite は天井から落ちてくる鍾乳石
+// 落ちる前からレーンに影が出ていて、落ちきるまでは当たらない
+type Stalactite struct {
+	StandardItem
+	falling bool
+	fall    float32 // 落下の進捗 [0, 1]
+}
+
+func NewStalactite(line *Line, x float32, lane int) *Stalactite {
+	return &Stalactite{
+		StandardItem: *NewItem(ItemStalactite, line, x, lane),
+	}
+}
+
+func (s *Stalactite) Update(dt float32) {
+	if !s.falling {
+		// 落ちきってからプレイヤーが届くまでに余裕を残して落とし始める
+		// 落ちる間にスピードバーストが始まっても間に合うように、バースト中の速さで判断する
+		dist := s.Position.X - s.line.player.position.X
+		speed := s.line.game.speed * SpeedBurstMultiplier
+		if dist < speed*stalactiteFallTime+HazardReactionMargin {
+			s.falling = true
+		}
+		return
+	}
+	if s.fall < 1 {
+		s.fall += dt / stalactiteFallTime
+		if s.fall > 1 {
+			s.fall = 1
+		}
+	}
+}
+
+// CollidesWith は落ちきるまで当たらない
+func (s *Stalactite) CollidesWith(pos Vector2d, width, height int) bool {
+	return s.fall >= 1 && s.StandardItem.CollidesWith(pos, width, height)
+}
+
+func (s *Stalactite) Draw(r Renderer, camera *Camera) {
+	screenPos := camera.WorldToScreen(s.Position)
+	x, y := Round(screenPos.X), Round(screenPos.Y)
+
+	// 予兆の影（落ちるにつれて濃くなる）
+	shadow := 0.4 + 0.6*s.fall
+	for sy := y + 12; sy < y+16; sy++ {
+		for sx := x + 2; sx < x+14; sx++ {
+			if shadow > bayerMatrix[(sx&3)+(sy&3)*4] {
+				r.Pix(sx, sy, 0)
+			}
+		}
+	}
+
+	// 下向きの三角形
+	offsetY := Round(-stalactiteHang * (1 - EaseInQuad(s.fall)))
+	for row := 0; row < 16; row++ {
+		half := 7 - row*7/16
+		r.Rect(x+8-half, y+offsetY+row, half*2, 1, s.Def().Color)
+	}
+}
+
+// --- Boulder ---
+
+const BoulderSpeed = 40.0 // 転がる速さ（ピクセル/秒）
+
+// Boulder はプレイヤーに向かって左へ転がってくる岩（ツルハシで壊せる）
+type Boulder struct {
+	StandardItem
+	anim float32
+}
+
+func NewBoulder(line *Line, x float32, lane int) *Boulder {
+	return &Boulder{
+		StandardItem: *NewItem(ItemBoulder, line, x, lane),
+	}
+}
+
+func (b *Boulder) Update(dt float32) {
+	b.Position.X -= BoulderSpeed * dt
+	b.anim += dt
+}
+
+func (b *Boulder) Draw(r Renderer, camera *Camera) {
+	def := b.Def()
+	screenPos := camera.WorldToScreen(b.Position)
+	x, y := Round(screenPos.X), Round(screenPos.Y)
+
+	// 反転を繰り返して転がっているように見せる
+	flip := int(b.anim*8)%2 == 0
+	r.Spr(def.Sprite, x, y, SpriteOptions{ColorKey: def.ColorKey, Scale: 1, Width: def.Width / 8, Height: def.Height / 8, FlipH: flip})
+
+	// 後ろに土ぼこり
+	for i := 0; i < 3; i++ {
+		if (int(b.anim*12)+i)%3 != 0 {
+			r.Pix(x+16+i*2, y+14-i, 14)
+		}
+	}
+}
@@ -0,0 +1,132 @@
+package game
+
+// ハイスコア表のpmemレイアウト（1スロット = 32bit）
+//
+//	[0]      マジック + バージョン
+//	[1]      チェックサム（[2]以降のFNV-1a）
+//	[2]      登録件数
+//	[3+4*i]  i位のエントリ: スコア, 到達レベル, 距離, シード
+const (
+	highScoreMagic      = 0x48530000 // "HS" + バージョン
+	highScoreVersion    = 1
+	highScoreSlotHeader = 3
+	highScoreEntrySlots = 4
+	MaxHighScores       = 10
+)
+
+// HighScoreEntry はハイスコア表の1件
+type HighScoreEntry struct {
+	Score    int
+	Level    int
+	Distance int // 総移動距離（ピクセル）
+	Seed     uint32
+}
+
+// HighScoreTable はスコア順に並んだハイスコア表（最大 MaxHighScores 件）
+type HighScoreTable struct {
+	entries []HighScoreEntry
+}
+
+// LoadHighScores は永続メモリからハイスコア表を読み込む
+// データが無い・バージョン違い・チェックサム不一致の場合は空の表を返す
+func LoadHighScores(storage Storage) *HighScoreTable {
+	t := &HighScoreTable{}
+	if storage == nil {
+		return t
+	}
+
+	if storage.Load(0) != highScoreMagic|highScoreVersion {
+		return t
+	}
+
+	count := int(storage.Load(2))
+	if count < 0 || count > MaxHighScores {
+		return t
+	}
+
+	slots := make([]uint32, 1+count*highScoreEntrySlots)
+	for i := range slots {
+		slots[i] = storage.Load(2 + i)
+	}
+	if storage.Load(1) != highScoreChecksum(slots) {
+		return t
+	}
+
+	for i := 0; i < count; i++ {
+		base := 1 + i*highScoreEntrySlots
+		t.entries = append(t.entries, HighScoreEntry{
+			Score:    int(slots[base]),
+			Level:    int(slots[base+1]),
+			Distance: int(slots[base+2]),
+			Seed:     slots[base+3],
+		})
+	}
+	return t
+}
+
+// Save はハイスコア表を永続メモリに書き込む
+func (t *HighScoreTable) Save(storage Storage) {
+	if storage == nil {
+		return
+	}
+
+	slots := make([]uint32, 0, 1+len(t.entries)*highScoreEntrySlots)
+	slots = append(slots, uint32(len(t.entries)))
+	for _, e := range t.entries {
+		slots = append(slots, uint32(e.Score), uint32(e.Level), uint32(e.Distance), e.Seed)
+	}
+
+	storage.Store(0, highScoreMagic|highScoreVersion)
+	storage.Store(1, highScoreChecksum(slots))
+	for i, v := range slots {
+		storage.Store(2+i, v)
+	}
+}
+
+// Insert はエントリを順位に従って挿入し、順位（0始まり）を返す
+// ランク外の場合は -1 を返す
+func (t *HighScoreTable) Insert(entry HighScoreEntry) int {
+	rank := len(t.entries)
+	for i, e := range t.entries {
+		if entry.Score > e.Score {
+			rank = i
+			break
+		}
+	}
+	if rank >= MaxHighScores {
+		return -1
+	}
+
+	t.entries = append(t.entries, HighScoreEntry{})
+	copy(t.entries[rank+1:], t.entries[rank:])
+	t.entries[rank] = entry
+	if len(t.entries) > MaxHighScores {
+		t.entries = t.entries[:MaxHighScores]
+	}
+	return rank
+}
+
+// Entries はスコア順のエントリを返す
+func (t *HighScoreTable) Entries() []HighScoreEntry {
+	return t.entries
+}
+
+// Best は最高スコアを返す（記録が無い場合は0）
+func (t *HighScoreTable) Best() int {
+	if len(t.entries) == 0 {
+		return 0
+	}
+	return t.entries[0].Score
+}
+
+// highScoreChecksum は FNV-1a (32bit) でスロット列のチェックサムを計算する
+func highScoreChecksum(slots []uint32) uint32 {
+	hash := uint32(2166136261)
+	for _, v := range slots {
+		for shift := 0; shift < 32; shift += 8 {
+			hash ^= (v >> uint(shift)) & 0xff
+			hash *= 16777619
+		}
+	}
+	return hash
+}
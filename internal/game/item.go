@@ -1,33 +1,47 @@
 package game
 
-import (
-	"github.com/sorucoder/tic80"
-)
-
+// Item はラインに置かれるアイテム
+// プレイヤーとぶつかったときの反応は各アイテムの OnCollide が決める
 type Item interface {
 	Updatable
 	Drawable
+	Collidable
 
 	GetPosition() Vector2d
 	SetPosition(pos Vector2d)
 	Width() int
 	Height() int
+	Kind() ItemKind
+	Def() *ItemDef
 	IsObstacle() bool
 	IsExpired() bool
 	CollidesWith(pos Vector2d, width, height int) bool
+	Outcome() ItemOutcome
 }
 
+// ItemOutcome はアイテムがどう処理されたか
+type ItemOutcome int
+
+const (
+	ItemMissed ItemOutcome = iota // 触れずに画面外へ流れた
+	ItemHit                       // 障害物にぶつかった（シールドで防いだ場合も含む）
+	ItemBroken                    // ツルハシで壊した
+	ItemEaten                     // Foodやパワーアップを取った
+)
+
 // 基本的なアイテム構造体
 type BaseItem struct {
 	line     *Line
 	Position Vector2d // エクスポート（座標リセット用）
 	width    int
 	height   int
+	consumed bool        // 衝突で取られた・壊された
+	outcome  ItemOutcome // consumed のときの結果
 }
 
 func (i *BaseItem) Update(dt float32) {
 	// アイテムは動かない（相対的に左に流れるように見えるが、実際にはカメラが右に進む）
-	// ただし、もしアイテム自体を動かす必要があるならここで処理
+	// 動く障害物（hazard.go）はUpdateを上書きする
 }
 
 func (i *BaseItem) GetPosition() Vector2d {
@@ -47,6 +61,9 @@ func (i *BaseItem) Height() int {
 }
 
 func (i *BaseItem) IsExpired() bool {
+	if i.consumed {
+		return true
+	}
 	// カメラの左端よりさらに左に行ったら削除
 	cameraX := i.line.game.GetCameraX()
 	return i.Position.X < cameraX-140 // 画面幅(240)/2 + マージン
@@ -60,106 +77,130 @@ func (i *BaseItem) CollidesWith(pos Vector2d, width, height int) bool {
 		i.Position.Y+float32(i.height) > pos.Y
 }
 
-// 岩。障害物。
-type Rock struct {
-	BaseItem
-}
-
-func NewRock(line *Line, x float32, lane int) *Rock {
-	y := line.GetLaneY(lane)
-	return &Rock{
-		BaseItem: BaseItem{
-			line:     line,
-			Position: Vector2d{x, y},
-			width:    16,
-			height:   16,
-		},
-	}
-}
-
-func (r *Rock) IsObstacle() bool {
-	return true
+// collidesWithHitbox は当たり判定をアイテム内の矩形に絞ったAABB衝突判定
+func (i *BaseItem) collidesWithHitbox(hitbox Hitbox, pos Vector2d, width, height int) bool {
+	x := i.Position.X + float32(hitbox.X)
+	y := i.Position.Y + float32(hitbox.Y)
+	return x < pos.X+float32(width) &&
+		x+float32(hitbox.W) > pos.X &&
+		y < pos.Y+float32(height) &&
+		y+float32(hitbox.H) > pos.Y
 }
 
-func (r *Rock) Draw(camera *Camera) {
-	screenPos := camera.WorldToScreen(r.Position)
-	tic80.Spr(386, Round(screenPos.X), Round(screenPos.Y), tic80.NewSpriteOptions().AddTransparentColor(2).SetScale(1).SetSize(2, 2))
+// Outcome はアイテムがどう処理されたかを返す（まだ取られていなければ ItemMissed）
+func (i *BaseItem) Outcome() ItemOutcome {
+	return i.outcome
 }
 
-// 食物。スコアになる。
-type Food struct {
-	BaseItem
+// consume はアイテムを取り除く（次の期限切れチェックで削除される）
+func (i *BaseItem) consume(outcome ItemOutcome) {
+	i.consumed = true
+	i.outcome = outcome
 }
 
-func NewFood(line *Line, x float32, lane int) *Food {
-	y := line.GetLaneY(lane)
-	return &Food{
-		BaseItem: BaseItem{
-			line:     line,
-			Position: Vector2d{x, y},
-			width:    16,
-			height:   16,
-		},
+// damage はプレイヤーにダメージを与えてアイテムを取り除く
+// HardRockへの衝突は、シールド発動中ならダメージの代わりにシールドを消費する
+func (i *BaseItem) damage(p *Player, kind ItemKind, energy float32) {
+	i.consume(ItemHit)
+	if p.line.game.absorbHit(kind) {
+		p.line.game.eventBus.Publish(ShieldAbsorbed{Kind: kind, Line: p.line.lineIndex, Position: p.position})
+		return
 	}
+	p.line.game.AddEnergy(energy)
+	p.hurtTimer = 0.5
+	p.line.game.eventBus.Publish(HardRockHit{Kind: kind, Line: p.line.lineIndex, Position: p.position, Energy: energy})
 }
 
-func (f *Food) IsObstacle() bool {
-	return false
-}
-
-func (f *Food) Draw(camera *Camera) {
-	screenPos := camera.WorldToScreen(f.Position)
-	tic80.Spr(384, Round(screenPos.X), Round(screenPos.Y), tic80.NewSpriteOptions().AddTransparentColor(14).SetScale(1).SetSize(2, 2))
+// collidingPlayer は衝突相手がプレイヤーならそれを返す
+func collidingPlayer(c Collidable) (*Player, bool) {
+	p, ok := c.(*Player)
+	return p, ok
 }
 
-// 金塊岩。壊すと高得点。
-type GoldRock struct {
+// StandardItem は定義表（ItemDefs）の内容だけで見た目と振る舞いが決まるアイテム
+type StandardItem struct {
 	BaseItem
+	kind ItemKind
 }
 
-func NewGoldRock(line *Line, x float32, lane int) *GoldRock {
+// NewItem は定義表の種類からアイテムを作成する
+func NewItem(kind ItemKind, line *Line, x float32, lane int) *StandardItem {
+	def := &ItemDefs[kind]
 	y := line.GetLaneY(lane)
-	return &GoldRock{
+	return &StandardItem{
 		BaseItem: BaseItem{
 			line:     line,
 			Position: Vector2d{x, y},
-			width:    16,
-			height:   16,
+			width:    def.Width,
+			height:   def.Height,
 		},
+		kind: kind,
 	}
 }
 
-func (g *GoldRock) IsObstacle() bool {
-	return true
+func (it *StandardItem) Kind() ItemKind {
+	return it.kind
 }
 
-func (g *GoldRock) Draw(camera *Camera) {
-	screenPos := camera.WorldToScreen(g.Position)
-	tic80.Spr(388, Round(screenPos.X), Round(screenPos.Y), tic80.NewSpriteOptions().AddTransparentColor(2).SetScale(1).SetSize(2, 2))
+func (it *StandardItem) Def() *ItemDef {
+	return &ItemDefs[it.kind]
 }
 
-// 硬い岩。壊せない障害物。
-type HardRock struct {
-	BaseItem
+func (it *StandardItem) IsObstacle() bool {
+	return it.Def().Obstacle
 }
 
-func NewHardRock(line *Line, x float32, lane int) *HardRock {
-	y := line.GetLaneY(lane)
-	return &HardRock{
-		BaseItem: BaseItem{
-			line:     line,
-			Position: Vector2d{x, y},
-			width:    16,
-			height:   16,
-		},
-	}
+func (it *StandardItem) CollidesWith(pos Vector2d, width, height int) bool {
+	return it.collidesWithHitbox(it.Def().GetHitbox(), pos, width, height)
 }
 
-func (h *HardRock) IsObstacle() bool {
-	return true
-}
+// OnCollide は定義表に従って反応する
+//   - 壊せる岩をツルハシ所持者が通ると壊れる（スコアがあればボーナス）
+//   - パワーアップは取ると発動する
+//   - それ以外はエネルギーが増減する（減る場合はダメージ）
+func (it *StandardItem) OnCollide(c Collidable) {
+	p, ok := collidingPlayer(c)
+	if !ok {
+		return
+	}
+	def := it.Def()
+	game := p.line.game
+
+	if def.PowerUp != PowerUpNone {
+		it.consume(ItemEaten)
+		game.ActivatePowerUp(def.PowerUp, def.Duration)
+		game.eventBus.Publish(PowerUpCollected{Kind: it.kind, PowerUp: def.PowerUp, Line: p.line.lineIndex, Position: p.position})
+		return
+	}
 
-func (h *HardRock) Draw(camera *Camera) {
-	screenPos := camera.WorldToScreen(h.Position)
-	tic80.Spr(390, Round(screenPos.X), Round(screenPos.Y), tic80.NewSpriteOptions().AddTransparentColor(2).SetScale(1).SetSize(2, 2))
+	if def.Breakable && p.HasPickaxe() {
+		it.consume(ItemBroken)
+		if def.ScoreDelta > 0 {
+			game.eventBus.Publish(GoldRockBroken{Kind: it.kind, Line: p.line.lineIndex, Position: p.position, Score: def.ScoreDelta})
+		} else {
+			game.eventBus.Publish(RockBroken{Kind: it.kind, Line: p.line.lineIndex, Position: p.position})
+		}
+		return
+	}
+
+	if def.EnergyDelta < 0 {
+		it.damage(p, it.kind, def.EnergyDelta)
+		return
+	}
+	it.consume(ItemEaten)
+	game.AddEnergy(def.EnergyDelta)
+	game.eventBus.Publish(FoodEaten{Kind: it.kind, Line: p.line.lineIndex, Position: p.position, Energy: def.EnergyDelta})
+}
+
+func (it *StandardItem) Draw(r Renderer, camera *Camera) {
+	def := it.Def()
+	screenPos := camera.WorldToScreen(it.Position)
+	if def.Sprite < 0 {
+		// スプライトの無いアイテムは丸と文字で描く
+		x, y := Round(screenPos.X), Round(screenPos.Y)
+		r.Circ(x+def.Width/2, y+def.Height/2, def.Width/2-1, def.Color)
+		r.Print(def.Label, x+def.Width/2-2, y+def.Height/2-2, PrintOptions{Color: 0, Small: true})
+		return
+	}
+	r.Spr(def.Sprite, Round(screenPos.X), Round(screenPos.Y), SpriteOptions{ColorKey: def.ColorKey, Scale: 1, Width: def.Width / 8, Height: def.Height / 8})
 }
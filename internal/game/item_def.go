@@ -0,0 +1,141 @@
+package game
+
+// ItemKind はアイテム定義表（ItemDefs）のインデックス
+type ItemKind int
+
+const (
+	ItemFood       ItemKind = iota // 食物。エネルギー回復
+	ItemRock                       // 岩。ツルハシで壊せる障害物
+	ItemGoldRock                   // 金塊岩。壊すと高得点
+	ItemHardRock                   // 硬い岩。壊せない障害物
+	ItemShield                     // パワーアップ: シールド
+	ItemMagnet                     // パワーアップ: マグネット
+	ItemSpeedBurst                 // パワーアップ: スピードバースト
+	ItemMole                       // 動く障害物: レーンを行き来するモグラ
+	ItemStalactite                 // 動く障害物: 落ちてくる鍾乳石
+	ItemBoulder                    // 動く障害物: 転がってくる岩
+)
+
+// Hitbox はアイテムの左上からの当たり判定の矩形（ピクセル）
+type Hitbox struct {
+	X, Y, W, H int
+}
+
+// SoundEffect は効果音のIDと音程
+type SoundEffect struct {
+	ID   int
+	Note int
+}
+
+// ItemDef はアイテムの種類ごとの見た目と振る舞い
+type ItemDef struct {
+	Name          string
+	Sprite        int    // スプライトID（左上, -1なら Color と Label で描く）
+	ColorKey      int    // 透過色
+	Width, Height int    // 大きさ（ピクセル, 8の倍数）
+	Hitbox        Hitbox // 当たり判定（W, Hが0なら全体）
+	Obstacle      bool   // 障害物か（ボットや生成の判断用）
+	Breakable     bool   // ツルハシで壊せるか
+	EnergyDelta   float32
+	ScoreDelta    int         // 壊したときのボーナススコア
+	Sfx           SoundEffect // 取った・壊したときの効果音（ダメージ時は共通の音）
+	ParticleColor int         // 壊したときのパーティクルの色（-1なら出さない）
+	Magnetic      bool        // マグネットで引き寄せられるか
+	PowerUp       PowerUpKind // 取ったときに発動するパワーアップ
+	Duration      float32     // パワーアップの効果時間（秒）
+	Color         int         // スプライトが無いときの色
+	Label         string      // スプライトが無いときの文字
+}
+
+// ItemDefs はアイテムの定義表
+// 種類の追加や調整はここだけで行う
+var ItemDefs = []ItemDef{
+	ItemFood: {
+		Name: "Food", Sprite: 384, ColorKey: 14, Width: 16, Height: 16,
+		EnergyDelta:   20,
+		Sfx:           SoundEffect{ID: 8, Note: 64},
+		ParticleColor: -1,
+		Magnetic:      true,
+	},
+	ItemRock: {
+		Name: "Rock", Sprite: 386, ColorKey: 2, Width: 16, Height: 16,
+		Obstacle: true, Breakable: true,
+		EnergyDelta:   -30,
+		Sfx:           SoundEffect{ID: 12, Note: 64},
+		ParticleColor: 13,
+	},
+	ItemGoldRock: {
+		Name: "GoldRock", Sprite: 388, ColorKey: 2, Width: 16, Height: 16,
+		Obstacle: true, Breakable: true,
+		EnergyDelta:   -30,
+		ScoreDelta:    500,
+		Sfx:           SoundEffect{ID: 11, Note: 64},
+		ParticleColor: 14,
+	},
+	ItemHardRock: {
+		Name: "HardRock", Sprite: 390, ColorKey: 2, Width: 16, Height: 16,
+		Obstacle:      true,
+		EnergyDelta:   -30,
+		Sfx:           SoundEffect{ID: 10, Note: 40},
+		ParticleColor: -1,
+	},
+	ItemShield: {
+		Name: "SHIELD", Sprite: -1, Width: 16, Height: 16,
+		PowerUp: PowerUpShield, Duration: 10,
+		Sfx:           SoundEffect{ID: 8, Note: 76},
+		ParticleColor: -1,
+		Color:         11, Label: "S",
+	},
+	ItemMagnet: {
+		Name: "MAGNET", Sprite: -1, Width: 16, Height: 16,
+		PowerUp: PowerUpMagnet, Duration: 8,
+		Sfx:           SoundEffect{ID: 8, Note: 76},
+		ParticleColor: -1,
+		Color:         5, Label: "M",
+	},
+	ItemSpeedBurst: {
+		Name: "BURST", Sprite: -1, Width: 16, Height: 16,
+		PowerUp: PowerUpSpeed, Duration: 5,
+		Sfx:           SoundEffect{ID: 8, Note: 76},
+		ParticleColor: -1,
+		Color:         4, Label: "B",
+	},
+	ItemMole: {
+		Name: "Mole", Sprite: -1, Width: 16, Height: 16,
+		Obstacle:      true,
+		EnergyDelta:   -30,
+		ParticleColor: -1,
+		Color:         15,
+	},
+	ItemStalactite: {
+		Name: "Stalactite", Sprite: -1, Width: 16, Height: 16,
+		Obstacle:      true,
+		EnergyDelta:   -30,
+		ParticleColor: -1,
+		Color:         13,
+	},
+	ItemBoulder: {
+		Name: "Boulder", Sprite: 386, ColorKey: 2, Width: 16, Height: 16,
+		Obstacle: true, Breakable: true,
+		EnergyDelta:   -30,
+		Sfx:           SoundEffect{ID: 12, Note: 64},
+		ParticleColor: 13,
+	},
+}
+
+// PowerUpItems はレベル生成で出すパワーアップのアイテム
+var PowerUpItems = []ItemKind{ItemShield, ItemMagnet, ItemSpeedBurst}
+
+// shieldSfx はシールドで衝突を防いだときの効果音
+var shieldSfx = SoundEffect{ID: 12, Note: 64}
+
+// damageSfx はダメージを受けたときの共通の効果音
+var damageSfx = SoundEffect{ID: 10, Note: 40}
+
+// GetHitbox は当たり判定の矩形を返す（未指定なら全体）
+func (d *ItemDef) GetHitbox() Hitbox {
+	if d.Hitbox.W == 0 || d.Hitbox.H == 0 {
+		return Hitbox{0, 0, d.Width, d.Height}
+	}
+	return d.Hitbox
+}
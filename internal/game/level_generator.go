@@ -5,9 +5,54 @@ type LevelGenerator interface {
 	// ShouldSpawn は新しいアイテムを生成すべきかどうかを判定
 	ShouldSpawn(game *Game) bool
 
-	// SpawnItem はアイテムを生成してLineに追加
-	SpawnItem(game *Game)
+	// PlanSpawn は次のグリッド1列に置くアイテムを返す（置くのは Game）
+	PlanSpawn(ctx SpawnContext) SpawnPlan
 
 	// OnCoordinateReset は座標リセット時に呼ばれる
 	OnCoordinateReset(offset float32)
+
+	// OnLevelUp はレベルが変わったときに呼ばれる（SetLevel でも呼ばれる）
+	OnLevelUp(level int)
+
+	// OnItemResolved はアイテムがラインから取り除かれるときに、その結果と共に呼ばれる
+	OnItemResolved(line int, item Item, outcome ItemOutcome)
+
+	// OnPickaxeTransfer はツルハシを受け渡したときに呼ばれる
+	OnPickaxeTransfer(from, to int)
+
+	// Reset はリスタート時に呼ばれる
+	// 同じシードで作り直した生成器と同じ展開になるよう初期状態に戻す（リプレイの再現のため）
+	Reset(seed uint32)
+}
+
+// DebugInfo はデバッグ表示に出す情報を持つレベル生成器
+type DebugInfo interface {
+	DebugText() string // 空文字列なら何も表示しない
+}
+
+// BaseLevelGenerator は何もしないフックの実装（埋め込んで必要なフックだけ上書きする）
+// Reset を上書きしない生成器はリスタートを知らないまま続きを生成するので、
+// 状態を持つ生成器は必ず Reset を実装すること
+type BaseLevelGenerator struct{}
+
+func (BaseLevelGenerator) OnLevelUp(level int)                                     {}
+func (BaseLevelGenerator) OnItemResolved(line int, item Item, outcome ItemOutcome) {}
+func (BaseLevelGenerator) OnPickaxeTransfer(from, to int)                          {}
+func (BaseLevelGenerator) Reset(seed uint32)                                       {}
+
+// generatorListener はイベントをレベル生成器のフックに伝える
+type generatorListener struct {
+	game *Game
+}
+
+func (l *generatorListener) OnEvent(ev Event) {
+	spawner := l.game.spawner
+	switch e := ev.(type) {
+	case LevelUp:
+		spawner.OnLevelUp(e.Level)
+	case PickaxeTransferred:
+		spawner.OnPickaxeTransfer(e.From, e.To)
+	case ItemResolved:
+		spawner.OnItemResolved(e.Line, e.Item, e.Outcome)
+	}
 }
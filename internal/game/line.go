@@ -1,7 +1,5 @@
 package game
 
-import "github.com/sorucoder/tic80"
-
 // 列。
 type Line struct {
 	game        *Game
@@ -27,66 +25,28 @@ func (l *Line) Update(dt float32) {
 	l.player.Update(dt)
 
 	// アイテム更新と削除（生成はGameで管理）
-	// 衝突判定も同時に行う
+	// 衝突判定も同時に行う（衝突時の反応は各アイテムの OnCollide が決める）
+	if l.game.HasPowerUp(PowerUpMagnet) {
+		l.pullFood(dt)
+	}
+
 	activeItems := l.items[:0]
 	playerPos, playerWidth, playerHeight := l.player.GetBounds()
 
 	for i := range l.items {
 		l.items[i].Update(dt)
 
-		// 衝突判定
 		if l.items[i].CollidesWith(playerPos, playerWidth, playerHeight) {
-			hasPickaxe := l.game.HasPickaxe(l.lineIndex)
-
-			// 衝突した場合の処理
-			if l.items[i].IsObstacle() {
-				// HardRock判定
-				_, isHardRock := l.items[i].(*HardRock)
-
-				// Rock/GoldRock: ツルハシ所持状態をチェック
-				if !isHardRock && hasPickaxe {
-					// ツルハシ所持: Rockを破壊（削除）
-					if _, ok := l.items[i].(*GoldRock); ok {
-						// GoldRock破壊ボーナス
-						l.game.score += 500
-						l.game.AddEffect(NewPoppingTextEffect("+500", playerPos.X, playerPos.Y-10, 4))
-						// SFX: GoldRock (11)
-						tic80.Sfx(tic80.NewSoundEffectOptions().SetId(11).SetNote(64))
-						// パーティクルを散らす
-						for k := 0; k < 10; k++ {
-							l.game.AddEffect(NewParticleEffect(playerPos.X+8, playerPos.Y+8, 14)) // 14=Yellow
-						}
-					} else {
-						// Normal Rock
-						// SFX: Normal Rock Destroy (12)
-						tic80.Sfx(tic80.NewSoundEffectOptions().SetId(12).SetNote(64))
-						// パーティクルを散らす (グレー: 13)
-						for k := 0; k < 10; k++ {
-							l.game.AddEffect(NewParticleEffect(playerPos.X+8, playerPos.Y+8, 13))
-						}
-					}
-					continue
-				} else {
-					// ツルハシ非所持 または HardRock: エネルギー減少
-					l.game.AddEnergy(-30)
-					l.game.AddEffect(NewPoppingTextEffect("-30", playerPos.X, playerPos.Y-10, 8))
-					tic80.Sfx(tic80.NewSoundEffectOptions().SetId(10).SetNote(40))
-					l.player.hurtTimer = 0.5
-					continue
-				}
-			} else {
-				l.game.AddEnergy(20)
-				l.game.AddEffect(NewPoppingTextEffect("+20", playerPos.X, playerPos.Y-10, 5))
-				// SFX: Food (08)
-				tic80.Sfx(tic80.NewSoundEffectOptions().SetId(8).SetNote(64))
-				continue
-			}
+			l.items[i].OnCollide(l.player)
+			l.player.OnCollide(l.items[i])
 		}
 
-		// 期限切れチェック
-		if !l.items[i].IsExpired() {
-			activeItems = append(activeItems, l.items[i])
+		// 期限切れチェック（取り除く前に結果を通知する）
+		if l.items[i].IsExpired() {
+			l.game.eventBus.Publish(ItemResolved{Item: l.items[i], Line: l.lineIndex, Outcome: l.items[i].Outcome()})
+			continue
 		}
+		activeItems = append(activeItems, l.items[i])
 	}
 	l.items = activeItems
 }
@@ -99,7 +59,23 @@ func (l *Line) ToggleLane() {
 		l.currentLane = 0
 	}
 	// SFX: Movement (13) Note: 33
-	tic80.Sfx(tic80.NewSoundEffectOptions().SetId(13).SetNote(33))
+	l.game.audio.Sfx(13, 33)
+}
+
+func (l *Line) GetLineIndex() int {
+	return l.lineIndex
+}
+
+func (l *Line) GetCurrentLane() int {
+	return l.currentLane
+}
+
+func (l *Line) GetPlayer() *Player {
+	return l.player
+}
+
+func (l *Line) GetItems() []Item {
+	return l.items
 }
 
 // 現在のY座標を計算（ラインとレーンに基づく）
@@ -119,13 +95,13 @@ func (l *Line) GetLaneY(lane int) float32 {
 	return lineY + laneOffset
 }
 
-func (l *Line) Draw(camera *Camera) {
+func (l *Line) Draw(r Renderer, camera *Camera) {
 	// アイテム描画
 	for i := range l.items {
-		l.items[i].Draw(camera)
+		l.items[i].Draw(r, camera)
 	}
 
-	l.player.Draw(camera)
+	l.player.Draw(r, camera)
 }
 
 func (l *Line) AddItem(item Item) {
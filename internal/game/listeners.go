@@ -0,0 +1,130 @@
+package game
+
+// Game が標準で登録するイベントリスナー
+// 新しい反応を追加するときは Line.Update を触らずにリスナーを足す
+
+// scoringListener はイベントに応じてスコアを加算する
+type scoringListener struct {
+	game *Game
+}
+
+func (l *scoringListener) OnEvent(ev Event) {
+	switch e := ev.(type) {
+	case RockBroken:
+		l.game.addScore(l.game.boostedScore(float32(e.Score)))
+	case GoldRockBroken:
+		l.game.addScore(float32(e.Score))
+	case LevelUp:
+		l.game.addScore(float32(e.Score))
+	}
+}
+
+// audioListener はイベントに応じて効果音とBGMを鳴らす
+type audioListener struct {
+	audio Audio
+}
+
+func (l *audioListener) OnEvent(ev Event) {
+	switch e := ev.(type) {
+	case RockBroken:
+		l.play(ItemDefs[e.Kind].Sfx)
+	case GoldRockBroken:
+		l.play(ItemDefs[e.Kind].Sfx)
+	case HardRockHit:
+		l.play(damageSfx)
+	case FoodEaten:
+		l.play(ItemDefs[e.Kind].Sfx)
+	case PowerUpCollected:
+		l.play(ItemDefs[e.Kind].Sfx)
+	case ShieldAbsorbed:
+		l.play(shieldSfx)
+	case LevelUp:
+		// SFX: Level Up (24)
+		l.audio.Sfx(24, 52)
+	case PickaxeTransferred:
+		// SFX: Pickaxe Transfer (14) Note: 57
+		l.audio.Sfx(14, 57)
+	case GameOver:
+		// Stop music
+		l.audio.Music(-1)
+		l.audio.Sfx(10, 40)
+	}
+}
+
+func (l *audioListener) play(sfx SoundEffect) {
+	l.audio.Sfx(sfx.ID, sfx.Note)
+}
+
+// effectsListener はイベントに応じてテキストやパーティクルを出す
+type effectsListener struct {
+	game *Game
+}
+
+func (l *effectsListener) OnEvent(ev Event) {
+	g := l.game
+	switch e := ev.(type) {
+	case RockBroken:
+		l.scatter(e.Position, ItemDefs[e.Kind].ParticleColor)
+	case GoldRockBroken:
+		g.AddEffect(NewPoppingTextEffect("+"+intToString(e.Score), e.Position.X, e.Position.Y-10, 4))
+		l.scatter(e.Position, ItemDefs[e.Kind].ParticleColor)
+	case HardRockHit:
+		g.AddEffect(NewPoppingTextEffect(intToString(int(e.Energy)), e.Position.X, e.Position.Y-10, 8))
+	case FoodEaten:
+		g.AddEffect(NewPoppingTextEffect("+"+intToString(int(e.Energy)), e.Position.X, e.Position.Y-10, 5))
+	case PowerUpCollected:
+		def := &ItemDefs[e.Kind]
+		g.AddEffect(NewPoppingTextEffect(def.Name, e.Position.X, e.Position.Y-10, def.Color))
+	case ShieldAbsorbed:
+		g.AddEffect(NewPoppingTextEffect("BLOCK", e.Position.X, e.Position.Y-10, 11))
+		l.scatter(e.Position, 11)
+	case LevelUp:
+		// エフェクト表示 (画面中央付近に)
+		g.AddEffect(NewPoppingTextEffect("LEVEL "+intToString(e.Level), e.CameraX, 60, 12))
+	case PickaxeTransferred:
+		g.AddEffect(NewTransferEffect(e.FromPos, e.ToPos, g.Speed()))
+	}
+}
+
+// scatter はプレイヤーの中心からパーティクルを散らす（色が-1なら出さない）
+func (l *effectsListener) scatter(pos Vector2d, color int) {
+	if color < 0 {
+		return
+	}
+	for k := 0; k < 10; k++ {
+		l.game.AddEffect(NewParticleEffect(l.game.fxRNG, pos.X+8, pos.Y+8, color))
+	}
+}
+
+// GameStats は1プレイ中のイベントの集計
+type GameStats struct {
+	RocksBroken      int
+	GoldRocksBroken  int
+	HardRockHits     int
+	FoodEaten        int
+	PickaxeTransfers int
+	LevelUps         int
+	PowerUps         int
+	ShieldBlocks     int
+}
+
+func (s *GameStats) OnEvent(ev Event) {
+	switch ev.(type) {
+	case RockBroken:
+		s.RocksBroken++
+	case GoldRockBroken:
+		s.GoldRocksBroken++
+	case HardRockHit:
+		s.HardRockHits++
+	case FoodEaten:
+		s.FoodEaten++
+	case PickaxeTransferred:
+		s.PickaxeTransfers++
+	case LevelUp:
+		s.LevelUps++
+	case PowerUpCollected:
+		s.PowerUps++
+	case ShieldAbsorbed:
+		s.ShieldBlocks++
+	}
+}
@@ -0,0 +1,29 @@
+package game
+
+// GameMode はタイトル画面で選べるモード（どのレベル生成器で、どのシードで遊ぶか）
+type GameMode struct {
+	Name      string           // タイトル画面に表示する名前
+	Generator string           // レベル生成器の登録名（リプレイに記録する）
+	Factory   GeneratorFactory // レベル生成器の作成
+	Seed      func() uint32    // このモードのシード（nilなら毎回ランダム）
+}
+
+// newSeed はこのモードで新しく遊ぶときのシードを返す
+func (m GameMode) newSeed() uint32 {
+	if m.Seed != nil {
+		return m.Seed()
+	}
+	return seedRNG.Uint32()
+}
+
+// DailySeed はUNIX時間の日付ごとに決まるシードを返す
+// 同じ日なら誰が遊んでも同じ展開になる
+func DailySeed(unixTime uint32) uint32 {
+	day := unixTime / 86400
+	// 日付が1つ違うだけでも全く違うシードになるように混ぜる
+	x := day*0x9e3779b9 + 0x7f4a7c15
+	x ^= x >> 16
+	x *= 0x85ebca6b
+	x ^= x >> 13
+	return x
+}
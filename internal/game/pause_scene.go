@@ -0,0 +1,93 @@
+package game
+
+// pauseItems はポーズメニューの項目
+var pauseItems = []string{"RESUME", "RESTART", "QUIT TO TITLE"}
+
+const (
+	pauseResume = iota
+	pauseRestart
+	pauseQuit
+)
+
+// PauseScene はプレイ中にGameの上に重ねるポーズメニュー
+// 下のGameは止まったまま暗く描画される
+type PauseScene struct {
+	BaseScene
+
+	sceneManager *SceneManager
+	game         *Game
+	cursor       int
+	time         float32
+}
+
+func NewPauseScene(sm *SceneManager, game *Game) *PauseScene {
+	return &PauseScene{
+		sceneManager: sm,
+		game:         game,
+	}
+}
+
+func (s *PauseScene) Update(dt float32) {
+	s.time += dt
+	platform := s.sceneManager.Platform()
+	input := platform.Input
+
+	// Yボタンでそのまま再開
+	if input.Btnp(ButtonY) {
+		s.sceneManager.PopScene()
+		return
+	}
+
+	if input.Btnp(ButtonUp) {
+		s.cursor = (s.cursor + len(pauseItems) - 1) % len(pauseItems)
+		platform.Audio.Sfx(13, 33)
+	}
+	if input.Btnp(ButtonDown) {
+		s.cursor = (s.cursor + 1) % len(pauseItems)
+		platform.Audio.Sfx(13, 33)
+	}
+
+	if !input.Btnp(ButtonA) {
+		return
+	}
+	platform.Audio.Sfx(8, 64)
+
+	switch s.cursor {
+	case pauseResume:
+		s.sceneManager.PopScene()
+	case pauseRestart:
+		// 同じシードで最初からやり直す
+		newGame := s.game.restart(platform)
+		newGame.SetSceneManager(s.sceneManager)
+		s.sceneManager.TransitionTo(newGame, DefaultTransition)
+	case pauseQuit:
+		s.sceneManager.TransitionTo(s.game.newTitleScene(), DefaultTransition)
+	}
+}
+
+func (s *PauseScene) Draw() {
+	r := s.sceneManager.Platform().Renderer
+
+	// プレイ画面を暗くする
+	DrawDitheredBlack(r, 0.5)
+
+	// メニュー枠
+	boxX, boxY, boxW, boxH := 64, 36, 112, 60
+	r.Rect(boxX, boxY, boxW, boxH, 0)
+	r.Rectb(boxX, boxY, boxW, boxH, 12)
+
+	DrawOutlinedText(r, "PAUSED", 102, boxY+6, 4, 0)
+
+	for i, item := range pauseItems {
+		y := boxY + 22 + i*10
+		color := 13
+		if i == s.cursor {
+			color = 12
+			// カーソルを点滅させる
+			if int(s.time*4)%2 == 0 {
+				r.Print(">", boxX+10, y, PrintOptions{Color: 4})
+			}
+		}
+		r.Print(item, boxX+20, y, PrintOptions{Color: color})
+	}
+}
@@ -0,0 +1,78 @@
+package game
+
+// Renderer は描画APIを抽象化するインターフェース（TIC-80のAPIに準拠）
+type Renderer interface {
+	Cls(color int)
+	Pix(x, y, color int)
+	Rect(x, y, width, height, color int)
+	Rectb(x, y, width, height, color int)
+	Circ(x, y, radius, color int)
+	// Print はテキストを描画し、描画幅を返す
+	Print(text string, x, y int, opts PrintOptions) int
+	Spr(id, x, y int, opts SpriteOptions)
+	// Map はマップの (mapX, mapY) から width x height タイルを (screenX, screenY) に描画する
+	Map(mapX, mapY, width, height, screenX, screenY int)
+}
+
+// SpriteOptions はSprの描画オプション
+type SpriteOptions struct {
+	ColorKey int  // 透過色 (-1で透過なし)
+	Scale    int  // 0は1として扱う
+	Width    int  // 8x8セル単位の幅 (0は1として扱う)
+	Height   int  // 8x8セル単位の高さ (0は1として扱う)
+	FlipH    bool // 左右反転
+}
+
+// PrintOptions はPrintの描画オプション
+type PrintOptions struct {
+	Color int
+	Small bool // 小さいフォントを使う
+}
+
+// Button は入力ボタン
+type Button int
+
+const (
+	ButtonA Button = iota
+	ButtonB
+	ButtonX
+	ButtonY
+	ButtonUp
+	ButtonDown
+
+	// ButtonCount はボタンの種類の数
+	ButtonCount = iota
+)
+
+// Input は入力APIを抽象化するインターフェース
+type Input interface {
+	// Btnp はボタンがこのフレームで押されたかを返す
+	Btnp(button Button) bool
+}
+
+// Audio はサウンドAPIを抽象化するインターフェース
+type Audio interface {
+	Sfx(id, note int)
+	// Music はBGMを再生する (-1で停止)
+	Music(track int)
+}
+
+// Storage は永続メモリ（TIC-80のpmem: 32bit x 256スロット）を抽象化するインターフェース
+type Storage interface {
+	Load(index int) uint32
+	Store(index int, value uint32)
+}
+
+// Logger はデバッグ出力を抽象化するインターフェース
+type Logger interface {
+	Trace(message string)
+}
+
+// Platform はゲームが利用するプラットフォーム実装一式
+type Platform struct {
+	Renderer Renderer
+	Input    Input
+	Audio    Audio
+	Storage  Storage
+	Logger   Logger // nilの場合は出力しない
+}
@@ -1,12 +1,14 @@
 package game
 
-import "github.com/sorucoder/tic80"
+// PlayerLaneSpeed はレーン移動の速さ（ピクセル/秒, 60fpsで1フレーム4ピクセル）
+const PlayerLaneSpeed = 240.0
 
 // プレイヤー。右に掘り進みながら縦横に動く
 type Player struct {
 	line     *Line
 	velocity Vector2d
 	position Vector2d
+	prevPos  Vector2d // 前回の更新時の位置（描画の外挿用）
 	animTime float32
 
 	// Visual Effects
@@ -19,11 +21,14 @@ func NewPlayer(line *Line) *Player {
 		line:        line,
 		velocity:    Vector2d{0, 0},
 		position:    Vector2d{120, line.GetY()}, // 初期X座標を120に変更
+		prevPos:     Vector2d{120, line.GetY()},
 		lastHolePos: Vector2d{120, line.GetY()},
 	}
 }
 
 func (p *Player) Update(dt float32) {
+	p.prevPos = p.position
+
 	dx := p.line.game.Speed() * dt
 	dy := float32(0.0)
 
@@ -32,15 +37,15 @@ func (p *Player) Update(dt float32) {
 
 	// Y座標をLineのレーンに同期（スムーズな移動）
 	targetY := p.line.GetY()
-	const moveSpeedY = 4.0 // 1フレームあたりの移動ピクセル数
+	moveY := float32(PlayerLaneSpeed) * dt
 
 	if p.position.Y < targetY {
-		p.position.Y += moveSpeedY
+		p.position.Y += moveY
 		if p.position.Y > targetY {
 			p.position.Y = targetY
 		}
 	} else if p.position.Y > targetY {
-		p.position.Y -= moveSpeedY
+		p.position.Y -= moveY
 		if p.position.Y < targetY {
 			p.position.Y = targetY
 		}
@@ -92,13 +97,16 @@ func (p *Player) getAnimFrame() int {
 	}
 }
 
-func (p *Player) Draw(camera *Camera) {
+func (p *Player) Draw(r Renderer, camera *Camera) {
 	// Wiggle Effect (ダメージ時)
+	// 固定ステップ間は前回の更新からの移動量でX方向を外挿する
 	drawPos := p.position
+	drawPos.X += (p.position.X - p.prevPos.X) * p.line.game.drawAlpha
 	if p.hurtTimer > 0 {
 		magnitude := p.hurtTimer * 8.0
-		offsetX := (RandomFloat32() - 0.5) * magnitude
-		offsetY := (RandomFloat32() - 0.5) * magnitude
+		rng := p.line.game.fxRNG
+		offsetX := (rng.Float32() - 0.5) * magnitude
+		offsetY := (rng.Float32() - 0.5) * magnitude
 
 		drawPos = drawPos.Add(Vector2d{offsetX, offsetY})
 	}
@@ -107,7 +115,11 @@ func (p *Player) Draw(camera *Camera) {
 	screenPos := camera.WorldToScreen(drawPos)
 
 	// スプライト描画 (Roundを使って座標丸め)
-	tic80.Spr(p.getAnimFrame(), Round(screenPos.X), Round(screenPos.Y), tic80.NewSpriteOptions().AddTransparentColor(14).SetScale(1).SetSize(2, 2))
+	r.Spr(p.getAnimFrame(), Round(screenPos.X), Round(screenPos.Y), SpriteOptions{ColorKey: 14, Scale: 1, Width: 2, Height: 2})
+
+	if p.line.game.HasPowerUp(PowerUpShield) {
+		drawShield(r, Round(screenPos.X), Round(screenPos.Y), p.line.game.GetPowerUpTime(PowerUpShield))
+	}
 
 	// ツルハシ描画
 	if p.line.game.HasPickaxe(p.line.lineIndex) {
@@ -117,7 +129,7 @@ func (p *Player) Draw(camera *Camera) {
 			sprite = 269
 		}
 		// スプライト268（16x8）を描画。プレイヤーの右側に配置
-		tic80.Spr(sprite, Round(screenPos.X)+16, Round(screenPos.Y), tic80.NewSpriteOptions().AddTransparentColor(0).SetScale(1).SetSize(1, 2))
+		r.Spr(sprite, Round(screenPos.X)+16, Round(screenPos.Y), SpriteOptions{ColorKey: 0, Scale: 1, Width: 1, Height: 2})
 	}
 }
 
@@ -127,3 +139,12 @@ func (p *Player) GetBounds() (pos Vector2d, width, height int) {
 	const playerHeight = 16
 	return p.position, playerWidth, playerHeight
 }
+
+// OnCollide はアイテムとの衝突を受け取る
+// 反応（ダメージ・回復・破壊）はアイテム側の OnCollide が決めるので、プレイヤーは何もしない
+func (p *Player) OnCollide(c Collidable) {}
+
+// HasPickaxe はこのプレイヤーがツルハシを持っているかを返す
+func (p *Player) HasPickaxe() bool {
+	return p.line.game.HasPickaxe(p.line.lineIndex)
+}
@@ -0,0 +1,142 @@
+package game
+
+// PowerUpKind はパワーアップの種類
+type PowerUpKind int
+
+const (
+	PowerUpNone   PowerUpKind = iota
+	PowerUpShield             // HardRockへの衝突を1回だけ防ぐ
+	PowerUpMagnet             // 同じラインの反対レーンのFoodを引き寄せる
+	PowerUpSpeed              // 一定時間スピードとスコアの増え方を上げる
+	powerUpCount
+)
+
+// SpeedBurstMultiplier はスピードバースト中のスピード倍率
+// レベル生成器はこの速さでも抜けられるかを確かめる
+const SpeedBurstMultiplier = 1.5
+
+// パワーアップの効果量
+const (
+	speedBurstScoreMultiplier = 2.0   // スコア倍率
+	magnetRange               = 80.0  // プレイヤーの前方何ピクセルまで引き寄せるか
+	magnetPullSpeed           = 120.0 // 引き寄せる速さ（ピクセル/秒）
+)
+
+// ActivatePowerUp はパワーアップを発動する（発動中なら残り時間を延ばす）
+func (g *Game) ActivatePowerUp(kind PowerUpKind, duration float32) {
+	if kind <= PowerUpNone || kind >= powerUpCount {
+		return
+	}
+	if g.powerUps[kind] < duration {
+		g.powerUps[kind] = duration
+	}
+	g.powerUpMax[kind] = g.powerUps[kind]
+}
+
+// HasPowerUp はパワーアップが発動中かを返す
+func (g *Game) HasPowerUp(kind PowerUpKind) bool {
+	return g.GetPowerUpTime(kind) > 0
+}
+
+// GetPowerUpTime はパワーアップの残り時間（秒）を返す
+func (g *Game) GetPowerUpTime(kind PowerUpKind) float32 {
+	if kind <= PowerUpNone || kind >= powerUpCount {
+		return 0
+	}
+	return g.powerUps[kind]
+}
+
+// updatePowerUps はパワーアップの残り時間を減らす
+func (g *Game) updatePowerUps(dt float32) {
+	for i := range g.powerUps {
+		if g.powerUps[i] > 0 {
+			g.powerUps[i] -= dt
+			if g.powerUps[i] < 0 {
+				g.powerUps[i] = 0
+			}
+		}
+	}
+}
+
+// absorbHit はHardRockへの衝突なら、シールドが発動中のときに消費して衝突を防ぐ
+func (g *Game) absorbHit(kind ItemKind) bool {
+	if kind != ItemHardRock || !g.HasPowerUp(PowerUpShield) {
+		return false
+	}
+	g.powerUps[PowerUpShield] = 0
+	return true
+}
+
+// addScore はスコアを加算する
+func (g *Game) addScore(amount float32) {
+	g.score += amount
+}
+
+// boostedScore はスピードバースト中なら倍率をかけたスコアを返す
+// 走行とRockの破壊によるスコアにだけ使い、GoldRockやレベルアップのボーナスにはかけない
+func (g *Game) boostedScore(amount float32) float32 {
+	if g.HasPowerUp(PowerUpSpeed) {
+		amount *= speedBurstScoreMultiplier
+	}
+	return amount
+}
+
+// pullFood はマグネット発動中、反対レーンにあるFoodをプレイヤーのレーンへ引き寄せる
+// 位置はレーンのY座標に向けて動かすだけなので、座標リセットの影響を受けない
+func (l *Line) pullFood(dt float32) {
+	playerX := l.player.position.X
+	targetY := l.GetY()
+	move := float32(magnetPullSpeed) * dt
+
+	for _, item := range l.items {
+		if !item.Def().Magnetic {
+			continue
+		}
+		pos := item.GetPosition()
+		if pos.X < playerX-8 || pos.X > playerX+magnetRange {
+			continue
+		}
+		if pos.Y < targetY {
+			pos.Y = min(pos.Y+move, targetY)
+		} else if pos.Y > targetY {
+			pos.Y = max(pos.Y-move, targetY)
+		}
+		item.SetPosition(pos)
+	}
+}
+
+// drawPowerUpTimers はスコアの下に発動中のパワーアップの残り時間を描画する
+func (g *Game) drawPowerUpTimers(r Renderer, x, y int) {
+	const barWidth = 16
+	// 見た目はパワーアップのアイテム定義に合わせる
+	for _, kind := range PowerUpItems {
+		def := &ItemDefs[kind]
+		remaining := g.powerUps[def.PowerUp]
+		if remaining <= 0 {
+			continue
+		}
+		// 残りわずかで点滅
+		if remaining < 1.5 && int(remaining*8)%2 == 0 {
+			x += 24
+			continue
+		}
+		r.Print(def.Label, x, y, PrintOptions{Color: def.Color, Small: true})
+		width := int(float32(barWidth) * remaining / g.powerUpMax[def.PowerUp])
+		r.Rect(x+5, y+1, width, 3, def.Color)
+		x += 24
+	}
+}
+
+// drawShield はシールド発動中のプレイヤーの周りに輪を描く
+func drawShield(r Renderer, screenX, screenY int, time float32) {
+	const points = 16
+	const radius = 11.0
+	cx := float64(screenX + 8)
+	cy := float64(screenY + 8)
+	for i := 0; i < points; i++ {
+		angle := float64(i)/points*TWO_PI + float64(time)*2
+		x := cx + fastSin(angle)*radius
+		y := cy + fastSin(angle+PI/2)*radius
+		r.Pix(int(x), int(y), 11)
+	}
+}
@@ -0,0 +1,246 @@
+package game
+
+import (
+	"errors"
+	"math"
+)
+
+// ButtonState は1フレーム内で押されたボタンの集合（ビットマスク）
+type ButtonState uint8
+
+// ReadButtons は入力から全てのボタンの押下状態をまとめて読み取る
+func ReadButtons(in Input) ButtonState {
+	var state ButtonState
+	for b := Button(0); b < ButtonCount; b++ {
+		if in.Btnp(b) {
+			state |= 1 << uint(b)
+		}
+	}
+	return state
+}
+
+// Pressed はボタンが押されているかを返す
+func (s ButtonState) Pressed(button Button) bool {
+	return s&(1<<uint(button)) != 0
+}
+
+// ReplayEvent はボタンが押されたフレームとその押下状態
+type ReplayEvent struct {
+	Frame   int
+	Buttons ButtonState
+}
+
+// Replay は1回のプレイを再現するための記録（シード + レベル生成器 + ボタン入力）
+type Replay struct {
+	Seed      uint32
+	Generator string        // レベル生成器の登録名（空なら標準の生成器）
+	Frames    int           // 記録した総フレーム数
+	Score     float32       // 記録終了時のスコア（再現の検証用）
+	Events    []ReplayEvent // ボタンが押されたフレームのみ
+}
+
+// replayMagic はリプレイのバイナリ形式のヘッダ（最後の1バイトはバージョン）
+// バージョン1にはレベル生成器の名前が無い
+var replayMagic = [4]byte{'G', 'R', 'P', 2}
+
+var (
+	ErrReplayFormat  = errors.New("replay: invalid format")
+	ErrReplayVersion = errors.New("replay: unsupported version")
+)
+
+// MarshalBinary はリプレイを以下の形式にエンコードする
+//
+//	magic[4] seed(u32le) generator(uvarint length, bytes) frames(uvarint) score(f32 bits, u32le)
+//	events: { frame delta(uvarint) buttons(u8) }...
+func (r *Replay) MarshalBinary() ([]byte, error) {
+	buf := make([]byte, 0, 16+len(r.Events)*3)
+	buf = append(buf, replayMagic[:]...)
+	buf = appendUint32(buf, r.Seed)
+	buf = appendUvarint(buf, uint32(len(r.Generator)))
+	buf = append(buf, r.Generator...)
+	buf = appendUvarint(buf, uint32(r.Frames))
+	buf = appendUint32(buf, math.Float32bits(r.Score))
+
+	last := 0
+	for _, e := range r.Events {
+		buf = appendUvarint(buf, uint32(e.Frame-last))
+		buf = append(buf, byte(e.Buttons))
+		last = e.Frame
+	}
+	return buf, nil
+}
+
+// UnmarshalBinary は MarshalBinary の形式からリプレイを復元する
+func (r *Replay) UnmarshalBinary(data []byte) error {
+	if len(data) < len(replayMagic)+4 {
+		return ErrReplayFormat
+	}
+	if data[0] != replayMagic[0] || data[1] != replayMagic[1] || data[2] != replayMagic[2] {
+		return ErrReplayFormat
+	}
+	version := data[3]
+	if version != 1 && version != replayMagic[3] {
+		return ErrReplayVersion
+	}
+	data = data[4:]
+
+	seed, data := readUint32(data)
+	generator := ""
+	if version >= 2 {
+		length, rest, ok := readUvarint(data)
+		if !ok || uint32(len(rest)) < length {
+			return ErrReplayFormat
+		}
+		generator = string(rest[:length])
+		data = rest[length:]
+	}
+	frames, data, ok := readUvarint(data)
+	if !ok || len(data) < 4 {
+		return ErrReplayFormat
+	}
+	scoreBits, data := readUint32(data)
+
+	events := []ReplayEvent{}
+	frame := 0
+	for len(data) > 0 {
+		var delta uint32
+		delta, data, ok = readUvarint(data)
+		if !ok || len(data) < 1 {
+			return ErrReplayFormat
+		}
+		frame += int(delta)
+		events = append(events, ReplayEvent{Frame: frame, Buttons: ButtonState(data[0])})
+		data = data[1:]
+	}
+
+	r.Seed = seed
+	r.Generator = generator
+	r.Frames = int(frames)
+	r.Score = math.Float32frombits(scoreBits)
+	r.Events = events
+	return nil
+}
+
+// ReplayRecorder はフレームごとのボタン入力をリプレイに記録する
+type ReplayRecorder struct {
+	replay Replay
+}
+
+func NewReplayRecorder(seed uint32) *ReplayRecorder {
+	return &ReplayRecorder{replay: Replay{Seed: seed}}
+}
+
+// Record は1フレーム分の入力を記録する
+func (rec *ReplayRecorder) Record(buttons ButtonState) {
+	if buttons != 0 {
+		rec.replay.Events = append(rec.replay.Events, ReplayEvent{Frame: rec.replay.Frames, Buttons: buttons})
+	}
+	rec.replay.Frames++
+}
+
+// Finish は最終スコアを記録してリプレイを返す
+func (rec *ReplayRecorder) Finish(score float32) *Replay {
+	rec.replay.Score = score
+	return &rec.replay
+}
+
+// ReplayPlayer は記録された入力をフレームごとに返す
+type ReplayPlayer struct {
+	replay *Replay
+	frame  int
+	next   int // 次に再生するイベントのインデックス
+}
+
+func NewReplayPlayer(replay *Replay) *ReplayPlayer {
+	return &ReplayPlayer{replay: replay}
+}
+
+// Next は次のフレームの入力を返す
+func (p *ReplayPlayer) Next() ButtonState {
+	var buttons ButtonState
+	if p.next < len(p.replay.Events) && p.replay.Events[p.next].Frame == p.frame {
+		buttons = p.replay.Events[p.next].Buttons
+		p.next++
+	}
+	p.frame++
+	return buttons
+}
+
+// Done は記録されたフレームを全て再生したかを返す
+func (p *ReplayPlayer) Done() bool {
+	return p.frame >= p.replay.Frames
+}
+
+// EncodeReplayText はリプレイをコンソールに貼り付けられる16進文字列にする
+func EncodeReplayText(r *Replay) string {
+	data, _ := r.MarshalBinary()
+	const digits = "0123456789abcdef"
+	text := make([]byte, len(data)*2)
+	for i, b := range data {
+		text[i*2] = digits[b>>4]
+		text[i*2+1] = digits[b&0x0f]
+	}
+	return string(text)
+}
+
+// DecodeReplayText は EncodeReplayText の16進文字列からリプレイを復元する
+func DecodeReplayText(text string) (*Replay, error) {
+	if len(text)%2 != 0 {
+		return nil, ErrReplayFormat
+	}
+	data := make([]byte, len(text)/2)
+	for i := range data {
+		hi, ok1 := hexValue(text[i*2])
+		lo, ok2 := hexValue(text[i*2+1])
+		if !ok1 || !ok2 {
+			return nil, ErrReplayFormat
+		}
+		data[i] = hi<<4 | lo
+	}
+	r := &Replay{}
+	if err := r.UnmarshalBinary(data); err != nil {
+		return nil, err
+	}
+	return r, nil
+}
+
+func hexValue(c byte) (byte, bool) {
+	switch {
+	case c >= '0' && c <= '9':
+		return c - '0', true
+	case c >= 'a' && c <= 'f':
+		return c - 'a' + 10, true
+	case c >= 'A' && c <= 'F':
+		return c - 'A' + 10, true
+	}
+	return 0, false
+}
+
+func appendUint32(buf []byte, v uint32) []byte {
+	return append(buf, byte(v), byte(v>>8), byte(v>>16), byte(v>>24))
+}
+
+func readUint32(data []byte) (uint32, []byte) {
+	v := uint32(data[0]) | uint32(data[1])<<8 | uint32(data[2])<<16 | uint32(data[3])<<24
+	return v, data[4:]
+}
+
+func appendUvarint(buf []byte, v uint32) []byte {
+	for v >= 0x80 {
+		buf = append(buf, byte(v)|0x80)
+		v >>= 7
+	}
+	return append(buf, byte(v))
+}
+
+func readUvarint(data []byte) (uint32, []byte, bool) {
+	var v uint32
+	for i := 0; i < len(data) && i < 5; i++ {
+		b := data[i]
+		v |= uint32(b&0x7f) << (7 * uint(i))
+		if b < 0x80 {
+			return v, data[i+1:], true
+		}
+	}
+	return 0, data, false
+}
@@ -13,6 +13,11 @@ func NewRNG(seed uint32) *RNG {
 	return &RNG{state: seed}
 }
 
+// Seed restarts the sequence as NewRNG(seed) would.
+func (r *RNG) Seed(seed uint32) {
+	*r = *NewRNG(seed)
+}
+
 // Uint32 returns a random uint32.
 func (r *RNG) Uint32() uint32 {
 	r.state ^= r.state << 13
@@ -26,7 +31,7 @@ func (r *RNG) Float32() float32 {
 	return float32(r.Uint32()) / 4294967295.0
 }
 
-// RandomIntn returns a random integer in [0, max).
+// Intn returns a random integer in [0, max).
 func (r *RNG) Intn(max int) int {
 	if max <= 0 {
 		return 0
@@ -4,41 +4,242 @@ package game
 type Scene interface {
 	Update(dt float32)
 	Draw()
+	// OnEnter はシーンが最初に更新される直前に呼ばれる
 	OnEnter()
+	// OnExit はシーンがスタックから取り除かれたときに呼ばれる
+	OnExit()
+	// OnPause は上に別のシーンが積まれたときに呼ばれる
+	OnPause()
+	// OnResume は上のシーンが取り除かれて再び最前面になったときに呼ばれる
+	OnResume()
 }
 
-// SceneManager は現在のシーンを管理し、遷移を制御する
+// BaseScene は何もしないライフサイクルフックを提供する（埋め込んで使う）
+type BaseScene struct{}
+
+func (BaseScene) OnEnter()  {}
+func (BaseScene) OnExit()   {}
+func (BaseScene) OnPause()  {}
+func (BaseScene) OnResume() {}
+
+// Interpolated は固定ステップ間の補間描画に対応するシーンが実装する
+type Interpolated interface {
+	// SetDrawAlpha は前回の更新から経過したステップの割合 [0, 1) を設定する
+	SetDrawAlpha(alpha float32)
+}
+
+// sceneEntry はシーンスタックの1要素
+type sceneEntry struct {
+	scene   Scene
+	overlay bool // trueなら下のシーンを止めたまま描画する
+	entered bool // OnEnterを呼んだか
+}
+
+// SceneManager はシーンのスタックを管理し、遷移を制御する
+// 最前面のシーンだけが更新され、オーバーレイの下のシーンは止まったまま描画される
 type SceneManager struct {
-	currentScene Scene
-	enteredScene bool
+	platform   Platform
+	input      *tickInput
+	fixedStep  *FixedStep
+	stack      []*sceneEntry
+	transition *transitionState // 進行中のシーン遷移（無ければnil）
 }
 
 // NewSceneManager は新しいシーンマネージャーを作成する
-func NewSceneManager() *SceneManager {
-	return &SceneManager{}
+func NewSceneManager(platform Platform) *SceneManager {
+	input := &tickInput{raw: platform.Input}
+	platform.Input = input
+
+	return &SceneManager{
+		platform:  platform,
+		input:     input,
+		fixedStep: NewFixedStep(FixedDelta, MaxStepsPerTick),
+	}
 }
 
-// ChangeScene は現在のシーンを変更する
+// Platform はシーンが利用するプラットフォーム実装を返す
+func (sm *SceneManager) Platform() Platform {
+	return sm.platform
+}
+
+// ChangeScene はスタックを全て取り除き、指定したシーンに切り替える
 func (sm *SceneManager) ChangeScene(scene Scene) {
-	sm.currentScene = scene
-	sm.enteredScene = true
+	for len(sm.stack) > 0 {
+		sm.popEntry()
+	}
+	sm.stack = append(sm.stack, &sceneEntry{scene: scene})
+}
+
+// TransitionTo は遷移演出をはさんでシーンを切り替える
+// 遷移中はどのシーンも更新されず、覆い終わった時点で ChangeScene する
+func (sm *SceneManager) TransitionTo(scene Scene, transition Transition) {
+	if transition.Kind == TransitionCut {
+		sm.ChangeScene(scene)
+		return
+	}
+	sm.transition = &transitionState{Transition: transition, next: scene}
+}
+
+// IsTransitioning はシーン遷移の途中かを返す
+func (sm *SceneManager) IsTransitioning() bool {
+	return sm.transition != nil
+}
+
+// TransitionProgress は古いシーンを覆う段階の進捗 [0, 1] を返す
+// 遷移中でなければ0、新しいシーンを見せる段階では1
+func (sm *SceneManager) TransitionProgress() float32 {
+	if sm.transition == nil {
+		return 0
+	}
+	return sm.transition.outProgress()
+}
+
+// PushScene は現在のシーンを一時停止し、その上にシーンを積む
+// overlay が true の場合、下のシーンは止まったまま描画され続ける
+func (sm *SceneManager) PushScene(scene Scene, overlay bool) {
+	if top := sm.top(); top != nil {
+		top.scene.OnPause()
+	}
+	sm.stack = append(sm.stack, &sceneEntry{scene: scene, overlay: overlay})
+}
+
+// PopScene は最前面のシーンを取り除き、下のシーンを再開する
+func (sm *SceneManager) PopScene() {
+	if len(sm.stack) == 0 {
+		return
+	}
+	sm.popEntry()
+	if top := sm.top(); top != nil {
+		top.scene.OnResume()
+	}
+}
+
+// CurrentScene は最前面のシーンを返す
+func (sm *SceneManager) CurrentScene() Scene {
+	if top := sm.top(); top != nil {
+		return top.scene
+	}
+	return nil
+}
+
+func (sm *SceneManager) top() *sceneEntry {
+	if len(sm.stack) == 0 {
+		return nil
+	}
+	return sm.stack[len(sm.stack)-1]
+}
+
+func (sm *SceneManager) popEntry() {
+	top := sm.stack[len(sm.stack)-1]
+	sm.stack[len(sm.stack)-1] = nil
+	sm.stack = sm.stack[:len(sm.stack)-1]
+	top.scene.OnExit()
 }
 
-// Update は現在のシーンのUpdateを呼び出す
+// Tick は現在時刻（ミリ秒）までの更新を固定ステップで行い、描画する
+// フレーム落ちした場合は MaxStepsPerTick 回まで追いつきの更新を行う
+func (sm *SceneManager) Tick(nowMs float32) {
+	sm.input.latch()
+
+	steps := sm.fixedStep.Advance(nowMs)
+	for i := 0; i < steps; i++ {
+		sm.Update(FixedDelta)
+	}
+
+	// 止まっているシーンは外挿しない
+	top := sm.top()
+	for _, e := range sm.stack {
+		if s, ok := e.scene.(Interpolated); ok {
+			if e == top {
+				s.SetDrawAlpha(sm.fixedStep.Alpha())
+			} else {
+				s.SetDrawAlpha(0)
+			}
+		}
+	}
+	sm.Draw()
+}
+
+// Update は最前面のシーンのUpdateを呼び出す
 func (sm *SceneManager) Update(dt float32) {
-	if sm.enteredScene {
-		sm.enteredScene = false
-		sm.currentScene.OnEnter()
+	if sm.transition != nil {
+		sm.updateTransition(dt)
+		sm.input.consume()
+		return
+	}
+
+	if top := sm.top(); top != nil {
+		if !top.entered {
+			top.entered = true
+			top.scene.OnEnter()
+		}
+		top.scene.Update(dt)
+	}
+
+	// 押下は1回の更新でだけ有効（追いつきの更新で重複させない）
+	sm.input.consume()
+}
+
+// updateTransition はシーン遷移を進める
+func (sm *SceneManager) updateTransition(dt float32) {
+	t := sm.transition
+	t.timer += dt
+
+	if !t.revealed {
+		if t.timer < t.Out {
+			return
+		}
+		// 覆い終わったので切り替える
+		sm.ChangeScene(t.next)
+		t.next = nil
+		t.revealed = true
+		t.timer = 0
 	}
 
-	if sm.currentScene != nil {
-		sm.currentScene.Update(dt)
+	if t.timer >= t.In {
+		sm.transition = nil
 	}
 }
 
-// Draw は現在のシーンのDrawを呼び出す
+// Draw は最前面のシーンと、その下にあるオーバーレイ越しに見えるシーンを下から順に描画する
 func (sm *SceneManager) Draw() {
-	if sm.currentScene != nil {
-		sm.currentScene.Draw()
+	if len(sm.stack) == 0 {
+		return
+	}
+	bottom := len(sm.stack) - 1
+	for bottom > 0 && sm.stack[bottom].overlay {
+		bottom--
+	}
+	for _, e := range sm.stack[bottom:] {
+		e.scene.Draw()
+	}
+
+	if sm.transition != nil {
+		DrawTransitionCover(sm.platform.Renderer, sm.transition.Kind, sm.transition.coverage())
+	}
+}
+
+// tickInput はTickごとに押下を読み取り、次の1回の更新にだけ渡す
+// 更新が行われないTickの押下も取りこぼさない
+type tickInput struct {
+	raw     Input
+	pending ButtonState
+	latched bool
+}
+
+func (in *tickInput) latch() {
+	in.pending |= ReadButtons(in.raw)
+	in.latched = true
+}
+
+func (in *tickInput) consume() {
+	in.pending = 0
+}
+
+func (in *tickInput) Btnp(button Button) bool {
+	if !in.latched {
+		// Tickを使わずにUpdateを直接呼ぶ場合はそのまま読む
+		return in.raw.Btnp(button)
 	}
+	return in.pending.Pressed(button)
 }
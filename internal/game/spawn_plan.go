@@ -0,0 +1,67 @@
+package game
+
+// Spawn はレベル生成器が置くアイテム1つ分の指示
+type Spawn struct {
+	Column  int // グリッド列（生成器が生成を始めてから数えた通し番号）
+	Line    int // ライン番号（0=上、1=下）
+	Lane    int // レーン（モグラは最初のレーン）
+	Kind    ItemKind
+	XOffset float32 // 列の左端からのずれ（ピクセル）
+}
+
+// SpawnPlan は1列分の生成結果
+// レベル生成器はラインに直接触らずにこれを返し、Game がアイテムにして置く
+// （ゲームを動かさずに生成結果を調べたり比べたりできるように）
+type SpawnPlan struct {
+	Column int     // グリッド列
+	X      float32 // 列の左端のワールドX座標
+	Spawns []Spawn // 全て Column の列のアイテム
+}
+
+// SpawnX は指示されたアイテムのワールドX座標を返す
+func (p *SpawnPlan) SpawnX(s Spawn) float32 {
+	return p.X + s.XOffset
+}
+
+// SpawnContext はレベル生成器が1列を生成するときに参照するゲームの状態
+type SpawnContext struct {
+	Speed        float32 // レベルのスクロール速度（スピードバーストの倍率はかけない）
+	Lanes        [2]int  // 各ラインのプレイヤーのレーン
+	PickaxeOwner int     // ツルハシの所持者
+	Energy       float32 // エネルギー
+}
+
+// NewSpawnContext はレベルの開始時点の状態を返す（ゲームを動かさずに生成結果を見るため）
+func NewSpawnContext(level int) SpawnContext {
+	return SpawnContext{Speed: LevelSpeed(level), Energy: initialEnergy}
+}
+
+// GetSpawnContext は現在の状態を返す
+func (g *Game) GetSpawnContext() SpawnContext {
+	ctx := SpawnContext{Speed: g.speed, PickaxeOwner: g.pickaxeOwner, Energy: g.energy}
+	for i := range g.lines {
+		ctx.Lanes[i] = g.lines[i].currentLane
+	}
+	return ctx
+}
+
+// placeSpawns は生成結果をアイテムにしてラインに置く
+func (g *Game) placeSpawns(plan SpawnPlan) {
+	for _, s := range plan.Spawns {
+		line := g.lines[s.Line]
+		line.AddItem(newSpawnedItem(s.Kind, line, plan.SpawnX(s), s.Lane))
+	}
+}
+
+// newSpawnedItem は種類に応じたアイテムを作成する（動く障害物を含む）
+func newSpawnedItem(kind ItemKind, line *Line, x float32, lane int) Item {
+	switch kind {
+	case ItemMole:
+		return NewMole(line, x, lane)
+	case ItemStalactite:
+		return NewStalactite(line, x, lane)
+	case ItemBoulder:
+		return NewBoulder(line, x, lane)
+	}
+	return NewItem(kind, line, x, lane)
+}
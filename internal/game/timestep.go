@@ -0,0 +1,64 @@
+package game
+
+const (
+	// FixedDelta は1回の更新で進める時間（秒）
+	FixedDelta = 1.0 / 60
+	// MaxStepsPerTick は1回のTickで追いつきのために実行する更新の上限
+	MaxStepsPerTick = 4
+)
+
+// FixedStep は経過時間を蓄積して固定ステップの更新回数を決める
+type FixedStep struct {
+	step        float32 // 秒
+	maxSteps    int
+	accumulator float32
+	lastTime    float32 // ミリ秒
+	started     bool
+}
+
+func NewFixedStep(step float32, maxSteps int) *FixedStep {
+	return &FixedStep{
+		step:     step,
+		maxSteps: maxSteps,
+	}
+}
+
+// Advance は現在時刻（ミリ秒, tic80.Time()）を受け取り、実行すべき更新回数を返す
+func (f *FixedStep) Advance(nowMs float32) int {
+	if !f.started {
+		// 最初のフレームは1ステップだけ進める
+		f.started = true
+		f.lastTime = nowMs
+		return 1
+	}
+
+	elapsed := (nowMs - f.lastTime) / 1000
+	f.lastTime = nowMs
+
+	if elapsed < 0 {
+		elapsed = 0
+	}
+	// 60fpsの揺らぎでステップ数が0と2を行き来しないよう、ほぼ1ステップなら丸める
+	if diff := elapsed - f.step; diff > -0.001 && diff < 0.001 {
+		elapsed = f.step
+	}
+
+	f.accumulator += elapsed
+
+	steps := 0
+	for f.accumulator >= f.step && steps < f.maxSteps {
+		f.accumulator -= f.step
+		steps++
+	}
+
+	// 上限を超えた遅れは捨てる（長い停止の後に早送りにならないように）
+	if f.accumulator >= f.step {
+		f.accumulator = 0
+	}
+	return steps
+}
+
+// Alpha は前回の更新から経過したステップの割合 [0, 1) を返す（描画の補間用）
+func (f *FixedStep) Alpha() float32 {
+	return f.accumulator / f.step
+}
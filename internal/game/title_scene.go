@@ -1,63 +1,87 @@
 package game
 
-import (
-	"github.com/sorucoder/tic80"
-)
+// GeneratorFactory はレベル生成器を作成する（rngはレベル生成専用の乱数）
+type GeneratorFactory func(rng *RNG) LevelGenerator
 
-type GeneratorFactory func() LevelGenerator
+// titleTransition はタイトルからゲームへの遷移（ゴーファーが潜る演出に合わせて1秒で暗くする）
+var titleTransition = Transition{Kind: TransitionFade, Out: 1.0, In: 0.3, Easing: EaseLinear}
 
 type TitleScene struct {
-	sceneManager    *SceneManager
-	ticks           int
-	genFactory      GeneratorFactory
-	isTransitioning bool
-	transitionTimer float32
+	BaseScene
+
+	sceneManager *SceneManager
+	time         float32 // シーン開始からの経過時間（秒）
+	modes        []GameMode
+	selected     int // 選んでいるモード
+	highScores   *HighScoreTable
 }
 
-func NewTitleScene(sm *SceneManager, genFactory GeneratorFactory) *TitleScene {
+func NewTitleScene(sm *SceneManager, modes []GameMode) *TitleScene {
 	return &TitleScene{
-		sceneManager:    sm,
-		ticks:           0,
-		genFactory:      genFactory,
-		isTransitioning: false,
-		transitionTimer: 0,
+		sceneManager: sm,
+		time:         0,
+		modes:        modes,
+		highScores:   LoadHighScores(sm.Platform().Storage),
 	}
 }
 
+// SelectMode は名前でモードを選ぶ（見つからなければそのまま）
+func (s *TitleScene) SelectMode(name string) {
+	for i, mode := range s.modes {
+		if mode.Name == name {
+			s.selected = i
+			return
+		}
+	}
+}
+
+// GetSelectedMode は選んでいるモードを返す
+func (s *TitleScene) GetSelectedMode() GameMode {
+	return s.modes[s.selected]
+}
+
 func (s *TitleScene) OnEnter() {
 	// BGM 0 (Title) Loop
-	tic80.Music(tic80.NewMusicOptions().SetTrack(0))
+	s.sceneManager.Platform().Audio.Music(0)
 }
 
 func (s *TitleScene) Update(dt float32) {
-	s.ticks++
-
-	if s.isTransitioning {
-		s.transitionTimer += dt
-		if s.transitionTimer > 1.0 {
-			newGame := NewGame(s.genFactory)
-			newGame.SetSceneManager(s.sceneManager)
-			s.sceneManager.ChangeScene(newGame)
-		}
+	s.time += dt
+	input := s.sceneManager.Platform().Input
+	if len(s.modes) == 0 {
 		return
 	}
 
-	// Zボタン (Aボタン) でゲーム開始
-	if tic80.Btnp(tic80.BUTTON_A, 60000, 60000) {
-		s.isTransitioning = true
-		s.transitionTimer = 0
+	// 上下でモードを選ぶ
+	if input.Btnp(ButtonUp) {
+		s.selected = (s.selected + len(s.modes) - 1) % len(s.modes)
+		s.sceneManager.Platform().Audio.Sfx(13, 33)
+	}
+	if input.Btnp(ButtonDown) {
+		s.selected = (s.selected + 1) % len(s.modes)
+		s.sceneManager.Platform().Audio.Sfx(13, 33)
+	}
 
+	// Zボタン (Aボタン) でゲーム開始
+	if input.Btnp(ButtonA) {
 		// BGM停止
-		tic80.Music(tic80.NewMusicOptions().SetTrack(-1))
-		tic80.Sfx(tic80.NewSoundEffectOptions().SetId(8).SetNote(64))
+		s.sceneManager.Platform().Audio.Music(-1)
+		s.sceneManager.Platform().Audio.Sfx(8, 64)
+
+		newGame := NewGame(s.sceneManager.Platform(), s.GetSelectedMode())
+		newGame.modes = s.modes
+		newGame.SetSceneManager(s.sceneManager)
+		s.sceneManager.TransitionTo(newGame, titleTransition)
 	}
 }
 
 func (s *TitleScene) Draw() {
-	tic80.Cls(0)
+	r := s.sceneManager.Platform().Renderer
+
+	r.Cls(0)
 
 	// map描画(BG)
-	tic80.Map(tic80.NewMapOptions().SetOffset(0, 17).SetSize(31, 12).SetPosition(0, 0))
+	r.Map(0, 17, 31, 12, 0, 0)
 
 	// Gopher Sprites
 	leftSprite := 256
@@ -65,48 +89,82 @@ func (s *TitleScene) Draw() {
 	offsetY := 0.0
 
 	// 決定時の演出
-	if s.isTransitioning {
+	if s.sceneManager.IsTransitioning() {
 		leftSprite = 258
 		rightSprite = 290
 
-		// ジャンプ演出 (0.0 -> 0.5秒でもぐる)
-		if s.transitionTimer < 1.0 {
-			t := s.transitionTimer * 2.0 // 0.0 -> 1.0
+		// ジャンプ演出 (遷移の前半でもぐる)
+		t := s.sceneManager.TransitionProgress() * 2.0 // 0.0 -> 1.0
+		if t < 1.0 {
 			jumpHeight := 20.0
 			offsetY = float64((4.0*t*(1.0-t)*float32(jumpHeight) - t*16.0))
 		}
 	}
 
 	// Left Gopher (Offset X: 48 - 20 = 28, Y: 26)
-	tic80.Spr(leftSprite, 28, 80-int(offsetY), tic80.NewSpriteOptions().AddTransparentColor(14).SetScale(1).SetSize(2, 2))
+	r.Spr(leftSprite, 28, 80-int(offsetY), SpriteOptions{ColorKey: 14, Scale: 1, Width: 2, Height: 2})
 
 	// Right Gopher (Offset X: 48 + 144 + 4 = 196, Y: 26)
 	// Flip horizontally
-	tic80.Spr(rightSprite, 196, 80-int(offsetY), tic80.NewSpriteOptions().AddTransparentColor(14).SetScale(1).SetSize(2, 2).FlipHorizontally())
+	r.Spr(rightSprite, 196, 80-int(offsetY), SpriteOptions{ColorKey: 14, Scale: 1, Width: 2, Height: 2, FlipH: true})
 
 	// map描画(FG)
-	tic80.Map(tic80.NewMapOptions().SetOffset(0, 29).SetSize(31, 5).SetPosition(0, 96))
+	r.Map(0, 29, 31, 5, 0, 96)
 
 	// タイトルロゴ
-	DrawOutlinedText("Gopher the Channel Miner", 56, 30, 3, 15)
+	DrawOutlinedText(r, "Gopher the Channel Miner", 56, 30, 3, 15)
 
 	// 点滅する "PRESS A TO START"
-	if (s.ticks/30)%2 == 0 {
-		DrawOutlinedText("PRESS A TO START", 80, 40, 12, 15)
+	if int(s.time*2)%2 == 0 {
+		DrawOutlinedText(r, "PRESS A TO START", 80, 40, 12, 15)
 	}
 
-	// 操作説明など
-	tic80.Print("A: MOVE UPPER PLAYER", 68, 65, tic80.NewPrintOptions().SetColor(11))
-	tic80.Print("B: MOVE LOWER PLAYER", 68, 75, tic80.NewPrintOptions().SetColor(9))
-	tic80.Print("X: SWAP PICKAXE", 68, 85, tic80.NewPrintOptions().SetColor(4))
+	// モード選択（上下で切り替え）
+	s.drawModeSelect(r)
+
+	// 操作説明とハイスコア表を交互に表示（5秒ごと）
+	if int(s.time/5)%2 == 0 {
+		r.Print("A: MOVE UPPER PLAYER", 68, 64, PrintOptions{Color: 11})
+		r.Print("B: MOVE LOWER PLAYER", 68, 72, PrintOptions{Color: 9})
+		r.Print("X: SWAP PICKAXE", 68, 80, PrintOptions{Color: 4})
+		r.Print("Y: PAUSE", 68, 88, PrintOptions{Color: 12})
+	} else {
+		s.drawHighScores(r)
+	}
 
 	// Gopher Copyright
-	tic80.Print("The Go gopher was designed", 48, 110, tic80.NewPrintOptions().SetColor(13))
-	tic80.Print("by Renee French", 84, 120, tic80.NewPrintOptions().SetColor(13))
+	r.Print("The Go gopher was designed", 48, 110, PrintOptions{Color: 13})
+	r.Print("by Renee French", 84, 120, PrintOptions{Color: 13})
+}
+
+// drawModeSelect は選んでいるモードを中央に描画する
+func (s *TitleScene) drawModeSelect(r Renderer) {
+	if len(s.modes) < 2 {
+		return
+	}
+	text := "- " + s.GetSelectedMode().Name + " -"
+	width := r.Print(text, 0, -10, PrintOptions{Small: true})
+	r.Print(text, 120-width/2, 49, PrintOptions{Color: 4, Small: true})
+}
+
+// drawHighScores はハイスコア表を2列（1-5位, 6-10位）で描画する
+func (s *TitleScene) drawHighScores(r Renderer) {
+	DrawOutlinedText(r, "HIGH SCORES", 88, 55, 4, 15)
+
+	entries := s.highScores.Entries()
+	if len(entries) == 0 {
+		r.Print("NO RECORDS YET", 92, 72, PrintOptions{Color: 13, Small: true})
+		return
+	}
 
-	// Transition Effect
-	if s.isTransitioning {
-		alpha := s.transitionTimer / 1.0 // 1.0秒で完了
-		DrawDitheredBlack(alpha)
+	for i, e := range entries {
+		x := 52 + (i/5)*72
+		y := 64 + (i%5)*8
+		color := 12
+		if i == 0 {
+			color = 4
+		}
+		text := intToString(i+1) + ". " + intToString(e.Score) + " L" + intToString(e.Level)
+		r.Print(text, x, y, PrintOptions{Color: color, Small: true})
 	}
 }
@@ -0,0 +1,139 @@
+package game
+
+// TransitionKind はシーン遷移の演出の種類
+type TransitionKind int
+
+const (
+	TransitionCut      TransitionKind = iota // 演出なしで切り替える
+	TransitionFade                           // ディザで黒にフェードする
+	TransitionWipe                           // 左から右へ黒で拭う
+	TransitionIris                           // 画面中央に向かって円が閉じる
+	TransitionDissolve                       // ランダムなピクセルで黒に溶ける
+)
+
+// Transition はシーン遷移の設定
+// 古いシーンを Out 秒かけて覆い、新しいシーンに切り替えてから In 秒かけて見せる
+type Transition struct {
+	Kind   TransitionKind
+	Out    float32    // 覆うまでの時間（秒）
+	In     float32    // 見せるまでの時間（秒）
+	Easing EasingFunc // nilなら線形
+}
+
+// シーン遷移の標準設定
+var (
+	DefaultTransition  = Transition{Kind: TransitionFade, Out: 0.4, In: 0.4, Easing: EaseInOutCubic}
+	GameOverTransition = Transition{Kind: TransitionIris, Out: 0.6, In: 0.4, Easing: EaseInOutCubic}
+)
+
+// transitionState は進行中のシーン遷移
+type transitionState struct {
+	Transition
+	next     Scene
+	timer    float32
+	revealed bool // 新しいシーンに切り替え済み（In の段階）
+}
+
+// outProgress は覆う段階の進捗 [0, 1] を返す（In の段階では1）
+func (t *transitionState) outProgress() float32 {
+	if t.revealed {
+		return 1
+	}
+	return clampProgress(t.timer, t.Out)
+}
+
+// coverage は画面を覆っている割合 [0, 1] を返す
+func (t *transitionState) coverage() float32 {
+	ease := t.Easing
+	if ease == nil {
+		ease = EaseLinear
+	}
+	if t.revealed {
+		return 1 - ease(clampProgress(t.timer, t.In))
+	}
+	return ease(clampProgress(t.timer, t.Out))
+}
+
+func clampProgress(timer, duration float32) float32 {
+	if duration <= 0 {
+		return 1
+	}
+	return float32(Clamp(float64(timer/duration), 0, 1))
+}
+
+// DrawTransitionCover は画面を coverage [0, 1] の割合で覆う
+func DrawTransitionCover(r Renderer, kind TransitionKind, coverage float32) {
+	if coverage <= 0 {
+		return
+	}
+
+	switch kind {
+	case TransitionFade:
+		DrawDitheredBlack(r, coverage)
+
+	case TransitionWipe:
+		r.Rect(0, 0, int(float32(ScreenWidth)*coverage+0.5), ScreenHeight, 0)
+
+	case TransitionIris:
+		if coverage >= 1 {
+			r.Cls(0)
+			return
+		}
+		// 画面の角まで届く半径から縮める
+		const maxRadius = 138.0 // sqrt(120^2 + 68^2)
+		radius := maxRadius * (1 - coverage)
+		cx, cy := ScreenWidth/2, ScreenHeight/2
+		for y := 0; y < ScreenHeight; y++ {
+			dy := float32(y - cy)
+			if dy*dy >= radius*radius {
+				r.Rect(0, y, ScreenWidth, 1, 0)
+				continue
+			}
+			// 円の内側は残し、左右の外側を塗る
+			halfWidth := Round(sqrtApprox(radius*radius - dy*dy))
+			r.Rect(0, y, cx-halfWidth, 1, 0)
+			r.Rect(cx+halfWidth, y, ScreenWidth-(cx+halfWidth), 1, 0)
+		}
+
+	case TransitionDissolve:
+		if coverage >= 1 {
+			r.Cls(0)
+			return
+		}
+		for y := 0; y < ScreenHeight; y++ {
+			for x := 0; x < ScreenWidth; x++ {
+				if dissolveThreshold(x, y) < coverage {
+					r.Pix(x, y, 0)
+				}
+			}
+		}
+
+	default:
+		if coverage >= 1 {
+			r.Cls(0)
+		}
+	}
+}
+
+// dissolveThreshold はピクセルごとに固定の疑似乱数 [0, 1) を返す
+func dissolveThreshold(x, y int) float32 {
+	h := uint32(x)*374761393 + uint32(y)*668265263
+	h = (h ^ (h >> 13)) * 1274126177
+	h ^= h >> 16
+	return float32(h&0xffff) / 65536.0
+}
+
+// sqrtApprox はニュートン法による平方根（mathパッケージを使わないため）
+func sqrtApprox(v float32) float32 {
+	if v <= 0 {
+		return 0
+	}
+	x := v
+	if x < 1 {
+		x = 1
+	}
+	for i := 0; i < 8; i++ {
+		x = 0.5 * (x + v/x)
+	}
+	return x
+}
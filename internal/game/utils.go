@@ -1,20 +1,12 @@
 package game
 
-// defaultRNG is the package-level default random number generator.
-var defaultRNG = NewRNG(123456789)
+// seedRNG is the package-level generator that picks the seed of each new run.
+// Gameplay randomness comes from the RNGs owned by each Game.
+var seedRNG = NewRNG(123456789)
 
-// SetRandomSeed sets the seed for the global default random number generator.
+// SetRandomSeed sets the seed used to pick the seeds of new runs.
 func SetRandomSeed(seed uint32) {
-	defaultRNG = NewRNG(seed)
-}
-
-func RandomFloat32() float32 {
-	return defaultRNG.Float32()
-}
-
-// RandomIntn accepts a max integer and returns a random integer in [0, max) using the default RNG.
-func RandomIntn(max int) int {
-	return defaultRNG.Intn(max)
+	seedRNG = NewRNG(seed)
 }
 
 // Round rounds a float32 to the nearest integer.
@@ -84,6 +76,24 @@ func Clamp(v, min, max float64) float64 {
 	return v
 }
 
+// EasingFunc maps progress t [0, 1] to an eased value [0, 1].
+type EasingFunc func(t float32) float32
+
+// EaseLinear returns t unchanged.
+func EaseLinear(t float32) float32 {
+	return t
+}
+
+// EaseInQuad accelerates from zero velocity.
+func EaseInQuad(t float32) float32 {
+	return t * t
+}
+
+// EaseOutQuad decelerates to zero velocity.
+func EaseOutQuad(t float32) float32 {
+	return t * (2 - t)
+}
+
 // EaseInOutCubic applies cubic easing in/out to t [0, 1].
 func EaseInOutCubic(t float32) float32 {
 	if t < 0.5 {
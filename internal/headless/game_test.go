@@ -0,0 +1,150 @@
+package headless_test
+
+import (
+	"math"
+	"testing"
+
+	"GolangGame251130/internal/game"
+	"GolangGame251130/internal/game/generators"
+	"GolangGame251130/internal/headless"
+)
+
+// newGame starts a classic game with the seed on the headless platform.
+func newGame(t *testing.T, seed uint32) (*game.Game, *headless.Input) {
+	t.Helper()
+	factory, ok := generators.DefaultRegistry.Lookup("path")
+	if !ok {
+		t.Fatal("path generator is not registered")
+	}
+	platform, input, _ := headless.New()
+	return game.NewGameWithSeed(platform, factory, seed), input
+}
+
+// run updates the game for frames fixed steps, pressing the buttons that
+// press returns for each frame, and returns the frames actually played.
+func run(g *game.Game, input *headless.Input, frames int, press func(frame int) []game.Button) int {
+	n := 0
+	for ; n < frames && !g.IsGameOver(); n++ {
+		if press != nil {
+			for _, b := range press(n) {
+				input.Press(b)
+			}
+		}
+		g.Update(game.FixedDelta)
+		input.Clear()
+	}
+	return n
+}
+
+func near(a, b float32) bool {
+	return math.Abs(float64(a-b)) < 0.01
+}
+
+// TestUpdateFirstSecond checks the score and energy before the first items
+// reach the gophers.
+func TestUpdateFirstSecond(t *testing.T) {
+	g, input := newGame(t, 1)
+	run(g, input, 60, nil)
+
+	if g.IsGameOver() {
+		t.Fatalf("game over after 1s: %v", g.GetGameOverCause())
+	}
+	if got, want := g.GetScore(), float32(10); !near(got, want) {
+		t.Errorf("score %g, want %g", got, want)
+	}
+	if got, want := g.GetEnergy(), 100-float32(game.EnergyDrainRate); !near(got, want) {
+		t.Errorf("energy %g, want %g", got, want)
+	}
+	if got := g.GetLevel(); got != 1 {
+		t.Errorf("level %d, want 1", got)
+	}
+}
+
+// TestUpdateIdleGameOver checks that a run nobody plays ends, and that the
+// game stops once it is over.
+func TestUpdateIdleGameOver(t *testing.T) {
+	g, input := newGame(t, 1)
+	frames := run(g, input, 120*60, nil)
+
+	if !g.IsGameOver() {
+		t.Fatalf("no game over after %d frames", frames)
+	}
+	if g.GetGameOverCause() == game.GameOverNone {
+		t.Error("game over without a cause")
+	}
+	if got := g.GetEnergy(); got > 0 {
+		t.Errorf("energy %g at game over", got)
+	}
+	// 10 points a second, plus bonuses
+	if got, least := g.GetScore(), float32(frames)*game.FixedDelta*10; got < least-0.01 {
+		t.Errorf("score %g after %d frames, want at least %g", got, frames, least)
+	}
+
+	score, distance := g.GetScore(), g.GetDistance()
+	for i := 0; i < 60; i++ {
+		g.Update(game.FixedDelta)
+	}
+	if g.GetScore() != score || g.GetDistance() != distance {
+		t.Errorf("game kept running after game over: score %g->%g, distance %g->%g",
+			score, g.GetScore(), distance, g.GetDistance())
+	}
+}
+
+// TestUpdateDeterministic checks that the same seed and input play the same run.
+func TestUpdateDeterministic(t *testing.T) {
+	press := func(frame int) []game.Button {
+		switch frame % 90 {
+		case 0:
+			return []game.Button{game.ButtonA}
+		case 45:
+			return []game.Button{game.ButtonB, game.ButtonX}
+		}
+		return nil
+	}
+
+	type result struct {
+		frames        int
+		score, energy float32
+		level         int
+		cause         game.GameOverCause
+	}
+	play := func() result {
+		g, input := newGame(t, 7)
+		frames := run(g, input, 60*60, press)
+		return result{frames, g.GetScore(), g.GetEnergy(), g.GetLevel(), g.GetGameOverCause()}
+	}
+
+	first, second := play(), play()
+	if first != second {
+		t.Errorf("runs differ: %+v and %+v", first, second)
+	}
+	if first.frames < 60 {
+		t.Errorf("run ended after %d frames", first.frames)
+	}
+}
+
+// TestSpeedBurstScore checks that the speed burst doubles the score for
+// breaking rocks but not the gold rock and level-up bonuses.
+func TestSpeedBurstScore(t *testing.T) {
+	tests := []struct {
+		name  string
+		event game.Event
+		want  float32
+	}{
+		{"rock", game.RockBroken{Kind: game.ItemRock, Score: 10}, 20},
+		{"gold rock", game.GoldRockBroken{Kind: game.ItemGoldRock, Score: 100}, 100},
+		{"level up", game.LevelUp{Level: 2, Score: 500}, 500},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g, _ := newGame(t, 1)
+			g.ActivatePowerUp(game.PowerUpSpeed, 5)
+			before := g.GetScore()
+			g.GetEventBus().Publish(tt.event)
+			if got := g.GetScore() - before; !near(got, tt.want) {
+				t.Errorf("score +%g, want +%g", got, tt.want)
+			}
+		})
+	}
+}
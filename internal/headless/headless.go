@@ -0,0 +1,86 @@
+// Package headless は描画・入力・サウンドを持たないホスト向けの game.Platform 実装
+//
+// go test やシミュレーションなど、TIC-80 を使わずにゲームロジックを動かすために使う。
+package headless
+
+import "GolangGame251130/internal/game"
+
+// New はヘッドレスなPlatformと、入力・サウンドを操作するための実体を返す
+func New() (game.Platform, *Input, *Audio) {
+	input := &Input{}
+	audio := &Audio{}
+	return game.Platform{
+		Renderer: Renderer{},
+		Input:    input,
+		Audio:    audio,
+		Storage:  &Storage{},
+	}, input, audio
+}
+
+// Renderer は何も描画しない game.Renderer
+type Renderer struct{}
+
+func (Renderer) Cls(color int)                                       {}
+func (Renderer) Pix(x, y, color int)                                 {}
+func (Renderer) Rect(x, y, width, height, color int)                 {}
+func (Renderer) Rectb(x, y, width, height, color int)                {}
+func (Renderer) Circ(x, y, radius, color int)                        {}
+func (Renderer) Spr(id, x, y int, opts game.SpriteOptions)           {}
+func (Renderer) Map(mapX, mapY, width, height, screenX, screenY int) {}
+
+// Print は描画せず、TIC-80のフォントで描画した場合のおおよその幅を返す
+func (Renderer) Print(text string, x, y int, opts game.PrintOptions) int {
+	charWidth := 6
+	if opts.Small {
+		charWidth = 4
+	}
+	return len(text) * charWidth
+}
+
+// Input はプログラムから押下状態を設定する game.Input
+//
+// Press したボタンは次の Clear まで押された扱いになる。
+type Input struct {
+	pressed [game.ButtonCount]bool
+}
+
+// Press はボタンをこのフレームで押された状態にする
+func (in *Input) Press(button game.Button) {
+	in.pressed[button] = true
+}
+
+// Clear は全てのボタンの押下状態を解除する（フレームの終わりに呼ぶ）
+func (in *Input) Clear() {
+	in.pressed = [game.ButtonCount]bool{}
+}
+
+func (in *Input) Btnp(button game.Button) bool {
+	return in.pressed[button]
+}
+
+// Audio は再生要求を記録する game.Audio
+type Audio struct {
+	Sfxs  []int // 再生されたSFXのID
+	Track int   // 最後に要求されたBGMトラック (-1で停止)
+}
+
+func (a *Audio) Sfx(id, note int) {
+	a.Sfxs = append(a.Sfxs, id)
+}
+
+func (a *Audio) Music(track int) {
+	a.Track = track
+}
+
+// Storage はメモリ上に保持する game.Storage（プロセス終了で消える）
+type Storage struct {
+	slots [256]uint32
+}
+
+func (s *Storage) Load(index int) uint32 {
+	return s.slots[index]
+}
+
+func (s *Storage) Store(index int, value uint32) {
+	s.slots[index] = value
+}
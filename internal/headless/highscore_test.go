@@ -0,0 +1,77 @@
+package headless_test
+
+import (
+	"reflect"
+	"testing"
+
+	"GolangGame251130/internal/game"
+	"GolangGame251130/internal/headless"
+)
+
+// savedTable saves a table of n entries and returns the storage and the entries.
+func savedTable(n int) (*headless.Storage, []game.HighScoreEntry) {
+	table := game.LoadHighScores(nil)
+	for i := 0; i < n; i++ {
+		table.Insert(game.HighScoreEntry{Score: 1000 + i*100, Level: 1 + i, Distance: 3000 * (i + 1), Seed: uint32(7 * i)})
+	}
+	storage := &headless.Storage{}
+	table.Save(storage)
+	return storage, table.Entries()
+}
+
+func TestHighScoresSaveLoad(t *testing.T) {
+	storage, entries := savedTable(12)
+	if len(entries) != game.MaxHighScores {
+		t.Fatalf("%d entries, want %d", len(entries), game.MaxHighScores)
+	}
+	for i := 1; i < len(entries); i++ {
+		if entries[i].Score > entries[i-1].Score {
+			t.Fatalf("entry %d (%d) above entry %d (%d)", i, entries[i].Score, i-1, entries[i-1].Score)
+		}
+	}
+	if entries[0].Score != 2100 {
+		t.Errorf("best %d, want 2100", entries[0].Score)
+	}
+
+	loaded := game.LoadHighScores(storage)
+	if !reflect.DeepEqual(loaded.Entries(), entries) {
+		t.Errorf("loaded %+v, saved %+v", loaded.Entries(), entries)
+	}
+	if rank := loaded.Insert(game.HighScoreEntry{Score: 500}); rank != -1 {
+		t.Errorf("score below the table ranked %d", rank)
+	}
+	if rank := loaded.Insert(game.HighScoreEntry{Score: 1550}); rank != 6 {
+		t.Errorf("score 1550 ranked %d, want 6", rank)
+	}
+}
+
+func TestHighScoresRejected(t *testing.T) {
+	tests := []struct {
+		name   string
+		tamper func(s *headless.Storage)
+	}{
+		{"checksum", func(s *headless.Storage) { s.Store(1, s.Load(1)+1) }},
+		{"score", func(s *headless.Storage) { s.Store(3, s.Load(3)+1) }},
+		{"count", func(s *headless.Storage) { s.Store(2, s.Load(2)-1) }},
+		{"version", func(s *headless.Storage) { s.Store(0, s.Load(0)+1) }},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			storage, _ := savedTable(3)
+			tt.tamper(storage)
+			if entries := game.LoadHighScores(storage).Entries(); len(entries) != 0 {
+				t.Errorf("loaded %+v from tampered storage", entries)
+			}
+		})
+	}
+}
+
+func TestHighScoresEmpty(t *testing.T) {
+	if entries := game.LoadHighScores(&headless.Storage{}).Entries(); len(entries) != 0 {
+		t.Errorf("loaded %+v from empty storage", entries)
+	}
+	if best := game.LoadHighScores(nil).Best(); best != 0 {
+		t.Errorf("best %d without storage", best)
+	}
+}
@@ -0,0 +1,75 @@
+package headless_test
+
+import (
+	"reflect"
+	"testing"
+
+	"GolangGame251130/internal/game"
+	"GolangGame251130/internal/game/generators"
+	"GolangGame251130/internal/headless"
+)
+
+// TestReplayRoundTrip records a short run, sends the replay through the text
+// encoding and plays it back with the same seed.
+func TestReplayRoundTrip(t *testing.T) {
+	// The assist generator adapts to the player, so a desync in the inputs
+	// also changes the stream.
+	factory, ok := generators.DefaultRegistry.Lookup(generators.GeneratorAssist)
+	if !ok {
+		t.Fatal("assist generator is not registered")
+	}
+	press := func(frame int) []game.Button {
+		switch frame % 75 {
+		case 0:
+			return []game.Button{game.ButtonA}
+		case 30:
+			return []game.Button{game.ButtonB}
+		case 50:
+			return []game.Button{game.ButtonX}
+		}
+		return nil
+	}
+
+	platform, input, _ := headless.New()
+	recorded := game.NewGameWithSeed(platform, factory, 11)
+	frames := run(recorded, input, 40*60, press)
+	replay := recorded.GetReplay()
+	if replay.Frames != frames {
+		t.Fatalf("replay has %d frames, the run %d", replay.Frames, frames)
+	}
+	if len(replay.Events) == 0 {
+		t.Fatal("no inputs recorded")
+	}
+
+	decoded, err := game.DecodeReplayText(game.EncodeReplayText(replay))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, replay) {
+		t.Fatalf("decoded replay differs:\n%+v\n%+v", decoded, replay)
+	}
+
+	platform, _, _ = headless.New()
+	played := game.NewReplayGame(platform, factory, decoded)
+	for !played.IsReplayFinished() && !played.IsGameOver() {
+		played.Update(game.FixedDelta)
+	}
+
+	if played.GetScore() != recorded.GetScore() || played.GetScore() != decoded.Score {
+		t.Errorf("score %g, recorded %g", played.GetScore(), recorded.GetScore())
+	}
+	if played.GetEnergy() != recorded.GetEnergy() {
+		t.Errorf("energy %g, recorded %g", played.GetEnergy(), recorded.GetEnergy())
+	}
+	if played.GetLevel() != recorded.GetLevel() || played.GetDistance() != recorded.GetDistance() {
+		t.Errorf("level %d distance %g, recorded level %d distance %g",
+			played.GetLevel(), played.GetDistance(), recorded.GetLevel(), recorded.GetDistance())
+	}
+	if played.IsGameOver() != recorded.IsGameOver() || played.GetGameOverCause() != recorded.GetGameOverCause() {
+		t.Errorf("game over %v (%v), recorded %v (%v)",
+			played.IsGameOver(), played.GetGameOverCause(), recorded.IsGameOver(), recorded.GetGameOverCause())
+	}
+	if played.GetStats() != recorded.GetStats() {
+		t.Errorf("stats %+v, recorded %+v", played.GetStats(), recorded.GetStats())
+	}
+}
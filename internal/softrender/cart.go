@@ -0,0 +1,88 @@
+// Package softrender は game.Renderer をメモリ上のフレームバッファに描画するソフトウェア実装
+//
+// スプライト・マップ・パレットは TIC-80 のカートリッジ (game.tic) から読み込む。
+// GPU や TIC-80 本体の無い CI でシーンの描画結果を比較するために使う。
+package softrender
+
+import "errors"
+
+const (
+	ScreenWidth  = 240
+	ScreenHeight = 136
+
+	MapWidth  = 240
+	MapHeight = 136
+
+	spriteCount = 512 // 0-255: タイル, 256-511: スプライト
+)
+
+// カートリッジのチャンク種別
+const (
+	chunkTiles   = 1
+	chunkSprites = 2
+	chunkMap     = 4
+	chunkPalette = 12
+)
+
+// Cart は描画に必要なカートリッジのデータ
+type Cart struct {
+	Sprites [spriteCount][64]uint8 // 8x8 のインデックスカラー
+	Map     [MapHeight][MapWidth]uint8
+	Palette [16][3]uint8 // RGB
+}
+
+var ErrCartFormat = errors.New("softrender: invalid cart format")
+
+// LoadCart は .tic 形式のカートリッジを読み込む（bank 0 のみ）
+func LoadCart(data []byte) (*Cart, error) {
+	c := &Cart{}
+	for len(data) > 0 {
+		if len(data) < 4 {
+			return nil, ErrCartFormat
+		}
+		kind := data[0] & 0x1f
+		bank := data[0] >> 5
+		size := int(data[1]) | int(data[2])<<8
+		data = data[4:]
+		if size > len(data) {
+			return nil, ErrCartFormat
+		}
+		chunk := data[:size]
+		data = data[size:]
+
+		if bank != 0 {
+			continue
+		}
+		switch kind {
+		case chunkTiles:
+			c.loadSprites(0, chunk)
+		case chunkSprites:
+			c.loadSprites(256, chunk)
+		case chunkMap:
+			for i, v := range chunk {
+				if i >= MapWidth*MapHeight {
+					break
+				}
+				c.Map[i/MapWidth][i%MapWidth] = v
+			}
+		case chunkPalette:
+			for i := 0; i < 16 && i*3+2 < len(chunk); i++ {
+				c.Palette[i] = [3]uint8{chunk[i*3], chunk[i*3+1], chunk[i*3+2]}
+			}
+		}
+	}
+	return c, nil
+}
+
+// loadSprites は 4bpp (下位ニブルが左のピクセル) のスプライトデータを展開する
+func (c *Cart) loadSprites(first int, chunk []byte) {
+	for i, b := range chunk {
+		id := first + i/32
+		if id >= spriteCount {
+			break
+		}
+		pixel := (i % 32) * 2
+		c.Sprites[id][pixel] = b & 0x0f
+		c.Sprites[id][pixel+1] = b >> 4
+	}
+}
@@ -0,0 +1,279 @@
+package softrender_test
+
+import (
+	"bytes"
+	"flag"
+	"image"
+	"image/png"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"GolangGame251130/internal/game"
+	"GolangGame251130/internal/game/generators"
+	"GolangGame251130/internal/headless"
+	"GolangGame251130/internal/softrender"
+)
+
+var update = flag.Bool("update", false, "rewrite the golden images in testdata")
+
+// cartPath is the cartridge the sprites, map and palette are loaded from.
+const cartPath = "../../game.tic"
+
+// Same fixed step as SceneManager.Tick
+const dt = game.FixedDelta
+
+// scene renders one golden image into the given renderer.
+type scene struct {
+	name   string
+	render func(platform game.Platform, input *headless.Input)
+}
+
+var scenes = []scene{
+	{"title", renderTitle},
+	{"title_highscores", renderTitleHighScores},
+	{"game", renderGame},
+	{"gameover", renderGameOver},
+	{"pause", renderPause},
+	{"powerups", renderPowerUps},
+	{"hazards", renderHazards},
+	{"title_transition", renderTitleTransition},
+	{"gameover_transition", renderGameOverTransition},
+}
+
+// TestGolden renders the scenes with the software renderer and compares them
+// with the PNGs in testdata. A differing image is written next to the golden
+// one as <name>.actual.png; run with -update after an intentional visual
+// change to rewrite the golden images.
+func TestGolden(t *testing.T) {
+	data, err := os.ReadFile(cartPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cart, err := softrender.LoadCart(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, s := range scenes {
+		t.Run(s.name, func(t *testing.T) {
+			renderer := softrender.NewRenderer(cart)
+			platform, input, _ := headless.New()
+			platform.Renderer = renderer
+			s.render(platform, input)
+			check(t, filepath.Join("testdata", s.name+".png"), renderer.Image())
+		})
+	}
+}
+
+// check compares img with the golden image at path (or rewrites it).
+func check(t *testing.T, path string, img image.Image) {
+	t.Helper()
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+
+	if *update {
+		if err := os.WriteFile(path, buf.Bytes(), 0o644); err != nil {
+			t.Fatal(err)
+		}
+		t.Logf("updated %s", path)
+		return
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	golden, err := png.Decode(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	diff := countDiff(golden, img)
+	if diff == 0 {
+		return
+	}
+	actual := path[:len(path)-len(".png")] + ".actual.png"
+	if err := os.WriteFile(actual, buf.Bytes(), 0o644); err != nil {
+		t.Fatal(err)
+	}
+	t.Errorf("%s: %d pixels differ (see %s)", path, diff, actual)
+}
+
+func countDiff(a, b image.Image) int {
+	if a.Bounds() != b.Bounds() {
+		return a.Bounds().Dx() * a.Bounds().Dy()
+	}
+	diff := 0
+	bounds := a.Bounds()
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			r1, g1, b1, _ := a.At(x, y).RGBA()
+			r2, g2, b2, _ := b.At(x, y).RGBA()
+			if r1 != r2 || g1 != g2 || b1 != b2 {
+				diff++
+			}
+		}
+	}
+	return diff
+}
+
+func genFactory(rng *game.RNG) game.LevelGenerator {
+	return generators.NewPathGenerator(rng)
+}
+
+// titleModes are the title screen modes, with a fixed seed for the daily mode.
+func titleModes() []game.GameMode {
+	return generators.DefaultModes(1)
+}
+
+func newGame(platform game.Platform) *game.Game {
+	return game.NewGameWithSeed(platform, genFactory, 1)
+}
+
+func renderTitle(platform game.Platform, input *headless.Input) {
+	sm := game.NewSceneManager(platform)
+	sm.ChangeScene(game.NewTitleScene(sm, titleModes()))
+	sm.Update(dt)
+	sm.Draw()
+}
+
+func renderTitleHighScores(platform game.Platform, input *headless.Input) {
+	table := game.LoadHighScores(platform.Storage)
+	for i := 0; i < 7; i++ {
+		table.Insert(game.HighScoreEntry{Score: 12000 - i*1500, Level: 7 - i, Distance: 20000 - i*3000, Seed: uint32(i)})
+	}
+	table.Save(platform.Storage)
+
+	sm := game.NewSceneManager(platform)
+	sm.ChangeScene(game.NewTitleScene(sm, titleModes()))
+	// 操作説明とハイスコア表は5秒ごとに切り替わる
+	for i := 0; i < 301; i++ {
+		sm.Update(dt)
+	}
+	sm.Draw()
+}
+
+func renderGame(platform game.Platform, input *headless.Input) {
+	sm := game.NewSceneManager(platform)
+	g := newGame(sm.Platform())
+	g.SetSceneManager(sm)
+	sm.ChangeScene(g)
+	for i := 0; i < 240; i++ {
+		sm.Update(dt)
+	}
+	sm.Draw()
+}
+
+func renderGameOver(platform game.Platform, input *headless.Input) {
+	sm := game.NewSceneManager(platform)
+	g := newGame(sm.Platform())
+	g.SetSceneManager(sm)
+	sm.ChangeScene(g)
+	for !g.IsGameOver() {
+		sm.Update(dt)
+	}
+	// スコアの移動アニメーションとプロンプトが出るまで待つ
+	for i := 0; i < 120; i++ {
+		sm.Update(dt)
+	}
+	sm.Draw()
+}
+
+func renderPause(platform game.Platform, input *headless.Input) {
+	sm := game.NewSceneManager(platform)
+	g := newGame(sm.Platform())
+	g.SetSceneManager(sm)
+	sm.ChangeScene(g)
+	for i := 0; i < 120; i++ {
+		sm.Update(dt)
+	}
+	input.Press(game.ButtonY)
+	sm.Update(dt)
+	input.Clear()
+	input.Press(game.ButtonDown)
+	sm.Update(dt)
+	input.Clear()
+	sm.Draw()
+}
+
+func renderPowerUps(platform game.Platform, input *headless.Input) {
+	sm := game.NewSceneManager(platform)
+	g := newGame(sm.Platform())
+	g.SetSceneManager(sm)
+	sm.ChangeScene(g)
+	for i := 0; i < 60; i++ {
+		sm.Update(dt)
+	}
+	g.ActivatePowerUp(game.PowerUpShield, 10)
+	g.ActivatePowerUp(game.PowerUpMagnet, 8)
+	g.ActivatePowerUp(game.PowerUpSpeed, 5)
+	// 画面内に各パワーアップを並べる
+	lines := g.GetLines()
+	x := g.GetCameraX() + 20
+	for i, kind := range game.PowerUpItems {
+		lines[1].AddItem(game.NewItem(kind, lines[1], x+float32(i)*24, 1))
+	}
+	for i := 0; i < 30; i++ {
+		sm.Update(dt)
+	}
+	sm.Draw()
+}
+
+func renderHazards(platform game.Platform, input *headless.Input) {
+	sm := game.NewSceneManager(platform)
+	g := newGame(sm.Platform())
+	g.SetSceneManager(sm)
+	sm.ChangeScene(g)
+	for i := 0; i < 60; i++ {
+		sm.Update(dt)
+	}
+	// 予兆中のモグラ、落下中の鍾乳石、転がる岩が同時に見える位置に置く
+	lines := g.GetLines()
+	x := g.GetCameraX()
+	lines[0].AddItem(game.NewMole(lines[0], x+100, 1))
+	lines[1].AddItem(game.NewStalactite(lines[1], x+60, 1))
+	lines[1].AddItem(game.NewBoulder(lines[1], x+140, 0))
+	for i := 0; i < 60; i++ {
+		sm.Update(dt)
+	}
+	sm.Draw()
+}
+
+func renderTitleTransition(platform game.Platform, input *headless.Input) {
+	sm := game.NewSceneManager(platform)
+	sm.ChangeScene(game.NewTitleScene(sm, titleModes()))
+	sm.Update(dt)
+	input.Press(game.ButtonA)
+	sm.Update(dt)
+	input.Clear()
+	// ゴーファーがジャンプの頂点付近でフェードが半分ほど進んだところ
+	for i := 0; i < 15; i++ {
+		sm.Update(dt)
+	}
+	sm.Draw()
+}
+
+func renderGameOverTransition(platform game.Platform, input *headless.Input) {
+	sm := game.NewSceneManager(platform)
+	g := newGame(sm.Platform())
+	g.SetSceneManager(sm)
+	sm.ChangeScene(g)
+	for !g.IsGameOver() {
+		sm.Update(dt)
+	}
+	for i := 0; i < 120; i++ {
+		sm.Update(dt)
+	}
+	input.Press(game.ButtonA)
+	sm.Update(dt)
+	input.Clear()
+	// アイリスが半分ほど閉じたところ
+	for i := 0; i < 18; i++ {
+		sm.Update(dt)
+	}
+	sm.Draw()
+}
@@ -0,0 +1,181 @@
+package softrender
+
+import (
+	"image"
+	"image/color"
+
+	"GolangGame251130/internal/game"
+)
+
+// Renderer は 240x136 のインデックスカラーのフレームバッファに描画する game.Renderer
+type Renderer struct {
+	cart   *Cart
+	pixels [ScreenWidth * ScreenHeight]uint8
+}
+
+// NewRenderer はカートリッジのデータを使うレンダラーを作成する
+func NewRenderer(cart *Cart) *Renderer {
+	return &Renderer{cart: cart}
+}
+
+// Pixel はフレームバッファの色番号を返す（画面外は0）
+func (r *Renderer) Pixel(x, y int) uint8 {
+	if x < 0 || y < 0 || x >= ScreenWidth || y >= ScreenHeight {
+		return 0
+	}
+	return r.pixels[y*ScreenWidth+x]
+}
+
+// Image はフレームバッファをカートリッジのパレットで画像にする
+func (r *Renderer) Image() *image.Paletted {
+	palette := make(color.Palette, 16)
+	for i, rgb := range r.cart.Palette {
+		palette[i] = color.RGBA{rgb[0], rgb[1], rgb[2], 0xff}
+	}
+	img := image.NewPaletted(image.Rect(0, 0, ScreenWidth, ScreenHeight), palette)
+	copy(img.Pix, r.pixels[:])
+	return img
+}
+
+func (r *Renderer) set(x, y, c int) {
+	if x < 0 || y < 0 || x >= ScreenWidth || y >= ScreenHeight {
+		return
+	}
+	r.pixels[y*ScreenWidth+x] = uint8(c % 16)
+}
+
+func (r *Renderer) Cls(c int) {
+	for i := range r.pixels {
+		r.pixels[i] = uint8(c % 16)
+	}
+}
+
+func (r *Renderer) Pix(x, y, c int) {
+	r.set(x, y, c)
+}
+
+func (r *Renderer) Rect(x, y, width, height, c int) {
+	for py := y; py < y+height; py++ {
+		for px := x; px < x+width; px++ {
+			r.set(px, py, c)
+		}
+	}
+}
+
+func (r *Renderer) Rectb(x, y, width, height, c int) {
+	if width <= 0 || height <= 0 {
+		return
+	}
+	for px := x; px < x+width; px++ {
+		r.set(px, y, c)
+		r.set(px, y+height-1, c)
+	}
+	for py := y; py < y+height; py++ {
+		r.set(x, py, c)
+		r.set(x+width-1, py, c)
+	}
+}
+
+func (r *Renderer) Circ(x, y, radius, c int) {
+	if radius < 0 {
+		return
+	}
+	for dy := -radius; dy <= radius; dy++ {
+		for dx := -radius; dx <= radius; dx++ {
+			if dx*dx+dy*dy <= radius*radius {
+				r.set(x+dx, y+dy, c)
+			}
+		}
+	}
+}
+
+// smallRows/smallCols は小さいフォントで使う 5x7 グリフの行・列
+var (
+	smallRows = [...]int{0, 2, 3, 4, 6}
+	smallCols = [...]int{0, 2, 4}
+)
+
+func (r *Renderer) Print(text string, x, y int, opts game.PrintOptions) int {
+	advance := 6
+	if opts.Small {
+		advance = 4
+	}
+
+	for i := 0; i < len(text); i++ {
+		g := glyph(text[i])
+		cx := x + i*advance
+		if opts.Small {
+			for col, gx := range smallCols {
+				for row, gy := range smallRows {
+					if g[gx]&(1<<uint(gy)) != 0 {
+						r.set(cx+col, y+row, opts.Color)
+					}
+				}
+			}
+			continue
+		}
+		for gx := 0; gx < 5; gx++ {
+			for gy := 0; gy < 7; gy++ {
+				if g[gx]&(1<<uint(gy)) != 0 {
+					r.set(cx+gx, y+gy, opts.Color)
+				}
+			}
+		}
+	}
+	return len(text) * advance
+}
+
+func (r *Renderer) Spr(id, x, y int, opts game.SpriteOptions) {
+	scale := max(opts.Scale, 1)
+	width := max(opts.Width, 1)
+	height := max(opts.Height, 1)
+
+	for cellY := 0; cellY < height; cellY++ {
+		for cellX := 0; cellX < width; cellX++ {
+			// 複数セルのスプライトはシート上で16個/行に並んでいる
+			sprite := id + cellY*16 + cellX
+			drawCellX := cellX
+			if opts.FlipH {
+				drawCellX = width - 1 - cellX
+			}
+			r.drawSprite(sprite, x+drawCellX*8*scale, y+cellY*8*scale, scale, opts.ColorKey, opts.FlipH)
+		}
+	}
+}
+
+// drawSprite は8x8のスプライトを1つ描画する
+func (r *Renderer) drawSprite(id, x, y, scale, colorKey int, flipH bool) {
+	if id < 0 || id >= spriteCount {
+		return
+	}
+	data := &r.cart.Sprites[id]
+	for py := 0; py < 8; py++ {
+		for px := 0; px < 8; px++ {
+			srcX := px
+			if flipH {
+				srcX = 7 - px
+			}
+			c := int(data[py*8+srcX])
+			if c == colorKey {
+				continue
+			}
+			for sy := 0; sy < scale; sy++ {
+				for sx := 0; sx < scale; sx++ {
+					r.set(x+px*scale+sx, y+py*scale+sy, c)
+				}
+			}
+		}
+	}
+}
+
+func (r *Renderer) Map(mapX, mapY, width, height, screenX, screenY int) {
+	for cy := 0; cy < height; cy++ {
+		for cx := 0; cx < width; cx++ {
+			// TIC-80と同様にマップ座標は折り返す
+			mx := ((mapX+cx)%MapWidth + MapWidth) % MapWidth
+			my := ((mapY+cy)%MapHeight + MapHeight) % MapHeight
+			tile := int(r.cart.Map[my][mx])
+			r.drawSprite(tile, screenX+cx*8, screenY+cy*8, 1, -1, false)
+		}
+	}
+}
@@ -0,0 +1,123 @@
+//go:build tinygo
+
+// Package ticplatform は game.Platform を TIC-80 のAPIで実装する
+package ticplatform
+
+import (
+	"github.com/sorucoder/tic80"
+
+	"GolangGame251130/internal/game"
+)
+
+// New はTIC-80向けのPlatformを作成する
+func New() game.Platform {
+	return game.Platform{
+		Renderer: Renderer{},
+		Input:    Input{},
+		Audio:    Audio{},
+		Storage:  Storage{},
+		Logger:   Logger{},
+	}
+}
+
+// Renderer は tic80 の描画APIを使う game.Renderer
+type Renderer struct{}
+
+func (Renderer) Cls(color int) {
+	tic80.Cls(color)
+}
+
+func (Renderer) Pix(x, y, color int) {
+	tic80.Pix(x, y, color)
+}
+
+func (Renderer) Rect(x, y, width, height, color int) {
+	tic80.Rect(x, y, width, height, color)
+}
+
+func (Renderer) Rectb(x, y, width, height, color int) {
+	tic80.Rectb(x, y, width, height, color)
+}
+
+func (Renderer) Circ(x, y, radius, color int) {
+	tic80.Circ(x, y, radius, color)
+}
+
+func (Renderer) Print(text string, x, y int, opts game.PrintOptions) int {
+	options := tic80.NewPrintOptions().SetColor(opts.Color)
+	if opts.Small {
+		options.TogglePage()
+	}
+	return tic80.Print(text, x, y, options)
+}
+
+func (Renderer) Spr(id, x, y int, opts game.SpriteOptions) {
+	options := tic80.NewSpriteOptions()
+	if opts.ColorKey >= 0 {
+		options.AddTransparentColor(opts.ColorKey)
+	}
+	if opts.Scale > 0 {
+		options.SetScale(opts.Scale)
+	}
+	width, height := opts.Width, opts.Height
+	if width <= 0 {
+		width = 1
+	}
+	if height <= 0 {
+		height = 1
+	}
+	options.SetSize(width, height)
+	if opts.FlipH {
+		options.FlipHorizontally()
+	}
+	tic80.Spr(id, x, y, options)
+}
+
+func (Renderer) Map(mapX, mapY, width, height, screenX, screenY int) {
+	tic80.Map(tic80.NewMapOptions().SetOffset(mapX, mapY).SetSize(width, height).SetPosition(screenX, screenY))
+}
+
+// Input は gamepad 1 のボタンを読む game.Input
+type Input struct{}
+
+var buttonCodes = [...]tic80.ButtonCode{
+	game.ButtonA:    tic80.BUTTON_A,
+	game.ButtonB:    tic80.BUTTON_B,
+	game.ButtonX:    tic80.BUTTON_X,
+	game.ButtonY:    tic80.BUTTON_Y,
+	game.ButtonUp:   tic80.BUTTON_UP,
+	game.ButtonDown: tic80.BUTTON_DOWN,
+}
+
+func (Input) Btnp(button game.Button) bool {
+	return tic80.Btnp(buttonCodes[button], 60000, 60000)
+}
+
+// Audio は tic80 のサウンドAPIを使う game.Audio
+type Audio struct{}
+
+func (Audio) Sfx(id, note int) {
+	tic80.Sfx(tic80.NewSoundEffectOptions().SetId(id).SetNote(note))
+}
+
+func (Audio) Music(track int) {
+	tic80.Music(tic80.NewMusicOptions().SetTrack(track))
+}
+
+// Storage は tic80 の pmem を使う game.Storage
+type Storage struct{}
+
+func (Storage) Load(index int) uint32 {
+	return tic80.Pmem(index, -1)
+}
+
+func (Storage) Store(index int, value uint32) {
+	tic80.Pmem(index, int64(value))
+}
+
+// Logger は tic80 のコンソールに出力する game.Logger
+type Logger struct{}
+
+func (Logger) Trace(message string) {
+	tic80.Trace(message, nil)
+}
@@ -1,3 +1,5 @@
+//go:build tinygo
+
 package main
 
 import (
@@ -5,6 +7,7 @@ import (
 
 	"GolangGame251130/internal/game"
 	"GolangGame251130/internal/game/generators"
+	"GolangGame251130/internal/ticplatform"
 )
 
 var sm *game.SceneManager
@@ -16,17 +19,12 @@ func BOOT() {
 	// reset rng seed
 	ts := tic80.Tstamp()
 	game.SetRandomSeed(ts)
-	sm = game.NewSceneManager()
-
-	genFactory := func() game.LevelGenerator {
-		return generators.NewPathGenerator()
-	}
+	sm = game.NewSceneManager(ticplatform.New())
 
-	sm.ChangeScene(game.NewTitleScene(sm, genFactory))
+	sm.ChangeScene(game.NewTitleScene(sm, generators.DefaultModes(game.DailySeed(ts))))
 }
 
 //go:export TIC
 func TIC() {
-	sm.Update(1.0 / 60)
-	sm.Draw()
+	sm.Tick(tic80.Time())
 }